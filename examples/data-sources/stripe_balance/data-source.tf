@@ -0,0 +1,5 @@
+data "stripe_balance" "example" {}
+
+output "available_usd" {
+  value = one([for b in data.stripe_balance.example.available : b.amount if b.currency == "usd"])
+}
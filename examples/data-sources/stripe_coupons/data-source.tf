@@ -0,0 +1 @@
+data "stripe_coupons" "example" {}
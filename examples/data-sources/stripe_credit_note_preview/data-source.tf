@@ -0,0 +1,15 @@
+data "stripe_credit_note_preview" "example" {
+  invoice = "in_..."
+  reason  = "order_change"
+  lines = [
+    {
+      type              = "invoice_line_item"
+      invoice_line_item = "il_..."
+      quantity          = 1
+    },
+  ]
+}
+
+output "credit_note_total" {
+  value = data.stripe_credit_note_preview.example.total
+}
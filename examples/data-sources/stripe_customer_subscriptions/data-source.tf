@@ -0,0 +1,4 @@
+data "stripe_customer_subscriptions" "example" {
+  customer = "cus_..."
+  status   = "all"
+}
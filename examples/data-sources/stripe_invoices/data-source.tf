@@ -0,0 +1,7 @@
+data "stripe_invoices" "example" {
+  customer = "cus_123"
+  status   = "open"
+  created = {
+    gte = provider::stripe::timestamp("2024-01-01T00:00:00Z")
+  }
+}
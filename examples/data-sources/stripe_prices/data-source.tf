@@ -0,0 +1,4 @@
+data "stripe_prices" "example" {
+  active  = true
+  product = "prod_123"
+}
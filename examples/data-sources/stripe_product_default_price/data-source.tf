@@ -0,0 +1,3 @@
+data "stripe_product_default_price" "example" {
+  product = "prod_..."
+}
@@ -0,0 +1,3 @@
+data "stripe_products" "example" {
+  active = true
+}
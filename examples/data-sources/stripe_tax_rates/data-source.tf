@@ -0,0 +1,4 @@
+data "stripe_tax_rates" "example" {
+  active    = true
+  inclusive = false
+}
@@ -0,0 +1,3 @@
+output "price_currency" {
+  value = provider::stripe::currency_upper(stripe_price.example.currency)
+}
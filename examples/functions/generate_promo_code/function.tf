@@ -0,0 +1,3 @@
+output "promo_code" {
+  value = provider::stripe::generate_promo_code("SUMMER-", 8, "summer-2025")
+}
@@ -0,0 +1,3 @@
+output "invalid_events" {
+  value = [for event in var.enabled_events : event if !provider::stripe::is_valid_event(event)]
+}
@@ -0,0 +1,4 @@
+resource "stripe_coupon" "example" {
+  percent_off = 25
+  redeem_by   = provider::stripe::timestamp("2025-12-31T00:00:00Z")
+}
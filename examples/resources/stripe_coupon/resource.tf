@@ -1,7 +1,8 @@
 resource "stripe_coupon" "example" {
-  name = "Example coupon"
+  name        = "Example coupon"
+  percent_off = 25
   applies_to = [
-    "price_...",
+    "prod_...",
   ]
   metadata = {
     foo = "bar"
@@ -0,0 +1,15 @@
+resource "stripe_customer" "example" {
+  name  = "Jenny Rosen"
+  email = "jenny.rosen@example.com"
+  address = {
+    line1       = "510 Townsend St"
+    city        = "San Francisco"
+    state       = "CA"
+    postal_code = "94103"
+    country     = "US"
+  }
+  preferred_locales = ["en"]
+  metadata = {
+    foo = "bar"
+  }
+}
@@ -0,0 +1,4 @@
+resource "stripe_customer_discount" "example" {
+  customer = "cus_..."
+  coupon   = stripe_coupon.example.id
+}
\ No newline at end of file
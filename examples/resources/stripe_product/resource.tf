@@ -4,3 +4,14 @@ resource "stripe_product" "example" {
     foo = "bar"
   }
 }
+
+resource "stripe_product" "with_default_price" {
+  name = "Example subscription"
+  default_price_data = {
+    currency    = "usd"
+    unit_amount = 1000
+    recurring = {
+      interval = "month"
+    }
+  }
+}
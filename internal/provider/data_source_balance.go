@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BalanceDataSource{}
+var _ datasource.DataSourceWithConfigure = &BalanceDataSource{}
+
+func NewBalanceDataSource() datasource.DataSource {
+	return &BalanceDataSource{}
+}
+
+// BalanceDataSource defines the data source implementation.
+type BalanceDataSource struct {
+	sc *client.API
+}
+
+// BalanceDataSourceModel describes the data source data model.
+type BalanceDataSourceModel struct {
+	Available types.List `tfsdk:"available"`
+	Livemode  types.Bool `tfsdk:"livemode"`
+	Pending   types.List `tfsdk:"pending"`
+}
+
+type BalanceAmountModel struct {
+	Amount   types.Int64  `tfsdk:"amount"`
+	Currency types.String `tfsdk:"currency"`
+}
+
+func (m BalanceAmountModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"amount":   types.Int64Type,
+		"currency": types.StringType,
+	}
+}
+
+func (d *BalanceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_balance"
+}
+
+func (d *BalanceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	amountObject := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"amount": schema.Int64Attribute{
+				MarkdownDescription: "Balance amount, in the smallest currency unit.",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Three-letter ISO currency code, in lowercase.",
+				Computed:            true,
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retrieves the current balance of the Stripe account.",
+
+		Attributes: map[string]schema.Attribute{
+			"available": schema.ListNestedAttribute{
+				MarkdownDescription: "Funds that are available to be transferred or paid out, per currency.",
+				Computed:            true,
+				NestedObject:        amountObject,
+			},
+			"livemode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the balance is of the live mode (`true`) or test mode (`false`) account.",
+				Computed:            true,
+			},
+			"pending": schema.ListNestedAttribute{
+				MarkdownDescription: "Funds that are not yet available in the balance, per currency.",
+				Computed:            true,
+				NestedObject:        amountObject,
+			},
+		},
+	}
+}
+
+func (d *BalanceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *BalanceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BalanceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	balance, err := d.sc.Balance.Get(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read balance, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, balance, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *BalanceDataSource) populateModel(ctx context.Context, model *BalanceDataSourceModel, balance *stripe.Balance, respDiag *diag.Diagnostics) {
+	model.Available = d.amountList(ctx, balance.Available, respDiag)
+	model.Livemode = types.BoolValue(balance.Livemode)
+	model.Pending = d.amountList(ctx, balance.Pending, respDiag)
+}
+
+func (d *BalanceDataSource) amountList(ctx context.Context, amounts []*stripe.Amount, respDiag *diag.Diagnostics) types.List {
+	items := make([]BalanceAmountModel, 0, len(amounts))
+	for _, amount := range amounts {
+		items = append(items, BalanceAmountModel{
+			Amount:   types.Int64Value(amount.Amount),
+			Currency: types.StringValue(string(amount.Currency)),
+		})
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: BalanceAmountModel{}.Types(),
+	}, items)
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	return list
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestPopulateModelBalanceDataSource(t *testing.T) {
+	amountType := types.ObjectType{AttrTypes: BalanceAmountModel{}.Types()}
+
+	cases := []struct {
+		name string
+		in   *stripe.Balance
+		want BalanceDataSourceModel
+	}{
+		{
+			name: "Empty balance",
+			in:   &stripe.Balance{},
+			want: BalanceDataSourceModel{
+				Available: types.ListValueMust(amountType, []attr.Value{}),
+				Livemode:  types.BoolValue(false),
+				Pending:   types.ListValueMust(amountType, []attr.Value{}),
+			},
+		},
+		{
+			name: "Multiple currencies",
+			in: &stripe.Balance{
+				Available: []*stripe.Amount{
+					{Amount: 12345, Currency: stripe.CurrencyUSD},
+					{Amount: 0, Currency: stripe.CurrencyEUR},
+				},
+				Livemode: true,
+				Pending: []*stripe.Amount{
+					{Amount: -500, Currency: stripe.CurrencyUSD},
+				},
+			},
+			want: BalanceDataSourceModel{
+				Available: types.ListValueMust(amountType, []attr.Value{
+					types.ObjectValueMust(BalanceAmountModel{}.Types(), map[string]attr.Value{
+						"amount":   types.Int64Value(12345),
+						"currency": types.StringValue("usd"),
+					}),
+					types.ObjectValueMust(BalanceAmountModel{}.Types(), map[string]attr.Value{
+						"amount":   types.Int64Value(0),
+						"currency": types.StringValue("eur"),
+					}),
+				}),
+				Livemode: types.BoolValue(true),
+				Pending: types.ListValueMust(amountType, []attr.Value{
+					types.ObjectValueMust(BalanceAmountModel{}.Types(), map[string]attr.Value{
+						"amount":   types.Int64Value(-500),
+						"currency": types.StringValue("usd"),
+					}),
+				}),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &BalanceDataSource{}
+			var model BalanceDataSourceModel
+			diags := diag.Diagnostics{}
+			d.populateModel(context.Background(), &model, tc.in, &diags)
+			assert.False(t, diags.HasError())
+
+			if !assert.Equal(t, tc.want, model) {
+				t.Errorf("unexpected result for model: %v", model)
+			}
+		})
+	}
+}
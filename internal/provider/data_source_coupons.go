@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CouponsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CouponsDataSource{}
+
+func NewCouponsDataSource() datasource.DataSource {
+	return &CouponsDataSource{}
+}
+
+// CouponsDataSource defines the data source implementation.
+type CouponsDataSource struct {
+	sc *client.API
+}
+
+// CouponsDataSourceModel describes the data source data model.
+type CouponsDataSourceModel struct {
+	IDs types.List `tfsdk:"ids"`
+}
+
+func (d *CouponsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupons"
+}
+
+func (d *CouponsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the IDs of the coupons of the account, such as for generating `import` blocks for `stripe_coupon`.",
+
+		Attributes: map[string]schema.Attribute{
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the coupons, sorted so that they do not reorder as coupons are created.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CouponsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *CouponsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CouponsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var coupons []*stripe.Coupon
+	iter := d.sc.Coupons.List(&stripe.CouponListParams{})
+	for iter.Next() {
+		coupons = append(coupons, iter.Coupon())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list coupons, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, coupons, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CouponsDataSource) populateModel(ctx context.Context, model *CouponsDataSourceModel, coupons []*stripe.Coupon, respDiag *diag.Diagnostics) {
+	ids := make([]string, 0, len(coupons))
+	for _, coupon := range coupons {
+		ids = append(ids, coupon.ID)
+	}
+	// Stripe lists the newest first, which would shift every ID when a
+	// coupon is created.
+	slices.Sort(ids)
+	list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	respDiag.Append(diags...)
+	model.IDs = list
+}
@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestPopulateModelCouponsDataSource(t *testing.T) {
+	d := &CouponsDataSource{}
+	var model CouponsDataSourceModel
+	diags := diag.Diagnostics{}
+	d.populateModel(context.Background(), &model, []*stripe.Coupon{
+		{ID: "WINTER"},
+		{ID: "SUMMER"},
+		{ID: "AUTUMN"},
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, testListValue(t, types.StringType, []string{"AUTUMN", "SUMMER", "WINTER"}), model.IDs)
+}
@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CreditNotePreviewDataSource{}
+var _ datasource.DataSourceWithConfigure = &CreditNotePreviewDataSource{}
+
+func NewCreditNotePreviewDataSource() datasource.DataSource {
+	return &CreditNotePreviewDataSource{}
+}
+
+// CreditNotePreviewDataSource defines the data source implementation.
+type CreditNotePreviewDataSource struct {
+	sc *client.API
+}
+
+// CreditNotePreviewDataSourceModel describes the data source data model.
+type CreditNotePreviewDataSourceModel struct {
+	Amount               types.Int64  `tfsdk:"amount"`
+	CreditAmount         types.Int64  `tfsdk:"credit_amount"`
+	Currency             types.String `tfsdk:"currency"`
+	DiscountAmount       types.Int64  `tfsdk:"discount_amount"`
+	Invoice              types.String `tfsdk:"invoice"`
+	Lines                types.List   `tfsdk:"lines"`
+	Reason               types.String `tfsdk:"reason"`
+	Subtotal             types.Int64  `tfsdk:"subtotal"`
+	SubtotalExcludingTax types.Int64  `tfsdk:"subtotal_excluding_tax"`
+	Total                types.Int64  `tfsdk:"total"`
+	TotalExcludingTax    types.Int64  `tfsdk:"total_excluding_tax"`
+}
+
+type CreditNotePreviewLineModel struct {
+	Amount          types.Int64  `tfsdk:"amount"`
+	Description     types.String `tfsdk:"description"`
+	InvoiceLineItem types.String `tfsdk:"invoice_line_item"`
+	Quantity        types.Int64  `tfsdk:"quantity"`
+	Type            types.String `tfsdk:"type"`
+	UnitAmount      types.Int64  `tfsdk:"unit_amount"`
+}
+
+func (m CreditNotePreviewLineModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"amount":            types.Int64Type,
+		"description":       types.StringType,
+		"invoice_line_item": types.StringType,
+		"quantity":          types.Int64Type,
+		"type":              types.StringType,
+		"unit_amount":       types.Int64Type,
+	}
+}
+
+func (d *CreditNotePreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credit_note_preview"
+}
+
+func (d *CreditNotePreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Previews the totals of a credit note for an invoice without issuing it.",
+
+		Attributes: map[string]schema.Attribute{
+			"amount": schema.Int64Attribute{
+				MarkdownDescription: "The total amount of the credit note, in the smallest currency unit. One of `amount`, `lines` or `credit_amount` is usually given.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"credit_amount": schema.Int64Attribute{
+				MarkdownDescription: "The amount to credit the customer's balance, in the smallest currency unit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Three-letter ISO currency code, in lowercase.",
+				Computed:            true,
+			},
+			"discount_amount": schema.Int64Attribute{
+				MarkdownDescription: "The total amount of discount that was credited, in the smallest currency unit.",
+				Computed:            true,
+			},
+			"invoice": schema.StringAttribute{
+				MarkdownDescription: "ID of the invoice to preview the credit note for.",
+				Required:            true,
+			},
+			"lines": schema.ListNestedAttribute{
+				MarkdownDescription: "Line items that make up the credit note.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"amount": schema.Int64Attribute{
+							MarkdownDescription: "The line item amount to credit, in the smallest currency unit. Only valid when `type` is `invoice_line_item`.",
+							Optional:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the credit note line item. Only valid when `type` is `custom_line_item`.",
+							Optional:            true,
+						},
+						"invoice_line_item": schema.StringAttribute{
+							MarkdownDescription: "The invoice line item to credit. Only valid when `type` is `invoice_line_item`.",
+							Optional:            true,
+						},
+						"quantity": schema.Int64Attribute{
+							MarkdownDescription: "The line item quantity to credit.",
+							Optional:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the credit note line item, one of `invoice_line_item` or `custom_line_item`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(stripe.CreditNoteLineItemTypeCustomLineItem),
+									string(stripe.CreditNoteLineItemTypeInvoiceLineItem),
+								),
+							},
+						},
+						"unit_amount": schema.Int64Attribute{
+							MarkdownDescription: "The integer unit amount in the smallest currency unit of the credit note line item. Only valid when `type` is `custom_line_item`.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason for issuing the credit note, one of `duplicate`, `fraudulent`, `order_change` or `product_unsatisfactory`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(stripe.CreditNoteReasonDuplicate),
+						string(stripe.CreditNoteReasonFraudulent),
+						string(stripe.CreditNoteReasonOrderChange),
+						string(stripe.CreditNoteReasonProductUnsatisfactory),
+					),
+				},
+			},
+			"subtotal": schema.Int64Attribute{
+				MarkdownDescription: "The amount of the credit note, excluding exclusive tax and invoice level discounts.",
+				Computed:            true,
+			},
+			"subtotal_excluding_tax": schema.Int64Attribute{
+				MarkdownDescription: "The amount of the credit note, excluding all tax and invoice level discounts.",
+				Computed:            true,
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "The amount of the credit note, including tax and all discounts.",
+				Computed:            true,
+			},
+			"total_excluding_tax": schema.Int64Attribute{
+				MarkdownDescription: "The amount of the credit note, excluding tax, but including discounts.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CreditNotePreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *CreditNotePreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CreditNotePreviewDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := d.buildParams(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creditNote, err := d.sc.CreditNotes.Preview(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to preview credit note, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(&data, creditNote)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CreditNotePreviewDataSource) buildParams(ctx context.Context, model CreditNotePreviewDataSourceModel, respDiag *diag.Diagnostics) *stripe.CreditNotePreviewParams {
+	params := &stripe.CreditNotePreviewParams{
+		Amount:       model.Amount.ValueInt64Pointer(),
+		CreditAmount: model.CreditAmount.ValueInt64Pointer(),
+		Invoice:      model.Invoice.ValueStringPointer(),
+		Reason:       model.Reason.ValueStringPointer(),
+	}
+
+	if !model.Lines.IsNull() && !model.Lines.IsUnknown() {
+		var lines []CreditNotePreviewLineModel
+		respDiag.Append(model.Lines.ElementsAs(ctx, &lines, false)...)
+		for _, line := range lines {
+			params.Lines = append(params.Lines, &stripe.CreditNotePreviewLineParams{
+				Amount:          line.Amount.ValueInt64Pointer(),
+				Description:     line.Description.ValueStringPointer(),
+				InvoiceLineItem: line.InvoiceLineItem.ValueStringPointer(),
+				Quantity:        line.Quantity.ValueInt64Pointer(),
+				Type:            line.Type.ValueStringPointer(),
+				UnitAmount:      line.UnitAmount.ValueInt64Pointer(),
+			})
+		}
+	}
+
+	return params
+}
+
+func (d *CreditNotePreviewDataSource) populateModel(model *CreditNotePreviewDataSourceModel, creditNote *stripe.CreditNote) {
+	model.Currency = types.StringValue(string(creditNote.Currency))
+	model.DiscountAmount = types.Int64Value(creditNote.DiscountAmount)
+	model.Subtotal = types.Int64Value(creditNote.Subtotal)
+	model.SubtotalExcludingTax = types.Int64Value(creditNote.SubtotalExcludingTax)
+	model.Total = types.Int64Value(creditNote.Total)
+	model.TotalExcludingTax = types.Int64Value(creditNote.TotalExcludingTax)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestBuildParamsCreditNotePreviewDataSource(t *testing.T) {
+	lineType := types.ObjectType{AttrTypes: CreditNotePreviewLineModel{}.Types()}
+
+	cases := []struct {
+		name string
+		in   CreditNotePreviewDataSourceModel
+		want *stripe.CreditNotePreviewParams
+	}{
+		{
+			name: "Amount",
+			in: CreditNotePreviewDataSourceModel{
+				Amount:       types.Int64Value(500),
+				CreditAmount: types.Int64Null(),
+				Invoice:      types.StringValue("in_123"),
+				Lines:        types.ListNull(lineType),
+				Reason:       types.StringValue("duplicate"),
+			},
+			want: &stripe.CreditNotePreviewParams{
+				Amount:  stripe.Int64(500),
+				Invoice: stripe.String("in_123"),
+				Reason:  stripe.String("duplicate"),
+			},
+		},
+		{
+			name: "Lines",
+			in: CreditNotePreviewDataSourceModel{
+				Amount:       types.Int64Null(),
+				CreditAmount: types.Int64Value(300),
+				Invoice:      types.StringValue("in_123"),
+				Lines: types.ListValueMust(lineType, []attr.Value{
+					types.ObjectValueMust(CreditNotePreviewLineModel{}.Types(), map[string]attr.Value{
+						"amount":            types.Int64Null(),
+						"description":       types.StringNull(),
+						"invoice_line_item": types.StringValue("il_123"),
+						"quantity":          types.Int64Value(1),
+						"type":              types.StringValue("invoice_line_item"),
+						"unit_amount":       types.Int64Null(),
+					}),
+					types.ObjectValueMust(CreditNotePreviewLineModel{}.Types(), map[string]attr.Value{
+						"amount":            types.Int64Null(),
+						"description":       types.StringValue("Goodwill"),
+						"invoice_line_item": types.StringNull(),
+						"quantity":          types.Int64Value(2),
+						"type":              types.StringValue("custom_line_item"),
+						"unit_amount":       types.Int64Value(100),
+					}),
+				}),
+				Reason: types.StringNull(),
+			},
+			want: &stripe.CreditNotePreviewParams{
+				CreditAmount: stripe.Int64(300),
+				Invoice:      stripe.String("in_123"),
+				Lines: []*stripe.CreditNotePreviewLineParams{
+					{
+						InvoiceLineItem: stripe.String("il_123"),
+						Quantity:        stripe.Int64(1),
+						Type:            stripe.String("invoice_line_item"),
+					},
+					{
+						Description: stripe.String("Goodwill"),
+						Quantity:    stripe.Int64(2),
+						Type:        stripe.String("custom_line_item"),
+						UnitAmount:  stripe.Int64(100),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &CreditNotePreviewDataSource{}
+			diags := diag.Diagnostics{}
+			params := d.buildParams(context.Background(), tc.in, &diags)
+
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, tc.want, params)
+		})
+	}
+}
+
+func TestPopulateModelCreditNotePreviewDataSource(t *testing.T) {
+	d := &CreditNotePreviewDataSource{}
+	model := CreditNotePreviewDataSourceModel{
+		Invoice: types.StringValue("in_123"),
+	}
+	d.populateModel(&model, &stripe.CreditNote{
+		Currency:             stripe.CurrencyUSD,
+		DiscountAmount:       50,
+		Subtotal:             1000,
+		SubtotalExcludingTax: 1000,
+		Total:                1150,
+		TotalExcludingTax:    950,
+	})
+
+	assert.Equal(t, CreditNotePreviewDataSourceModel{
+		Currency:             types.StringValue("usd"),
+		DiscountAmount:       types.Int64Value(50),
+		Invoice:              types.StringValue("in_123"),
+		Subtotal:             types.Int64Value(1000),
+		SubtotalExcludingTax: types.Int64Value(1000),
+		Total:                types.Int64Value(1150),
+		TotalExcludingTax:    types.Int64Value(950),
+	}, model)
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomerSubscriptionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CustomerSubscriptionsDataSource{}
+
+func NewCustomerSubscriptionsDataSource() datasource.DataSource {
+	return &CustomerSubscriptionsDataSource{}
+}
+
+// CustomerSubscriptionsDataSource defines the data source implementation.
+type CustomerSubscriptionsDataSource struct {
+	sc *client.API
+}
+
+// CustomerSubscriptionsDataSourceModel describes the data source data model.
+type CustomerSubscriptionsDataSourceModel struct {
+	Customer      types.String `tfsdk:"customer"`
+	Status        types.String `tfsdk:"status"`
+	Subscriptions types.List   `tfsdk:"subscriptions"`
+}
+
+type CustomerSubscriptionModel struct {
+	Id     types.String `tfsdk:"id"`
+	Status types.String `tfsdk:"status"`
+}
+
+func (m CustomerSubscriptionModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.StringType,
+		"status": types.StringType,
+	}
+}
+
+func (d *CustomerSubscriptionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_subscriptions"
+}
+
+func (d *CustomerSubscriptionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the subscriptions of a customer.",
+
+		Attributes: map[string]schema.Attribute{
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The ID of the customer whose subscriptions will be listed.",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list subscriptions with this status. Passing `all` includes canceled subscriptions. Defaults to all subscriptions that are not canceled.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"active",
+						"all",
+						"canceled",
+						"ended",
+						"incomplete",
+						"incomplete_expired",
+						"past_due",
+						"paused",
+						"trialing",
+						"unpaid",
+					),
+				},
+			},
+			"subscriptions": schema.ListNestedAttribute{
+				MarkdownDescription: "The customer's subscriptions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier for the subscription.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the subscription.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomerSubscriptionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *CustomerSubscriptionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CustomerSubscriptionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &stripe.SubscriptionListParams{
+		Customer: data.Customer.ValueStringPointer(),
+	}
+	if !data.Status.IsNull() {
+		params.Status = data.Status.ValueStringPointer()
+	}
+
+	var subscriptions []*stripe.Subscription
+	iter := d.sc.Subscriptions.List(params)
+	for iter.Next() {
+		subscriptions = append(subscriptions, iter.Subscription())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list customer subscriptions, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, subscriptions, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *CustomerSubscriptionsDataSource) populateModel(ctx context.Context, model *CustomerSubscriptionsDataSourceModel, subscriptions []*stripe.Subscription, respDiag *diag.Diagnostics) {
+	items := make([]CustomerSubscriptionModel, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		items = append(items, CustomerSubscriptionModel{
+			Id:     types.StringValue(subscription.ID),
+			Status: types.StringValue(string(subscription.Status)),
+		})
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: CustomerSubscriptionModel{}.Types(),
+	}, items)
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	model.Subscriptions = list
+}
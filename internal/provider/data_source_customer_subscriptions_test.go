@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestPopulateModelCustomerSubscriptionsDataSource(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []*stripe.Subscription
+		want types.List
+	}{
+		{
+			name: "No subscriptions",
+			in:   nil,
+			want: types.ListValueMust(types.ObjectType{
+				AttrTypes: CustomerSubscriptionModel{}.Types(),
+			}, []attr.Value{}),
+		},
+		{
+			name: "Multiple subscriptions",
+			in: []*stripe.Subscription{
+				{
+					ID:     "sub_1",
+					Status: stripe.SubscriptionStatusActive,
+				},
+				{
+					ID:     "sub_2",
+					Status: stripe.SubscriptionStatusPastDue,
+				},
+			},
+			want: types.ListValueMust(types.ObjectType{
+				AttrTypes: CustomerSubscriptionModel{}.Types(),
+			}, []attr.Value{
+				types.ObjectValueMust(CustomerSubscriptionModel{}.Types(), map[string]attr.Value{
+					"id":     types.StringValue("sub_1"),
+					"status": types.StringValue("active"),
+				}),
+				types.ObjectValueMust(CustomerSubscriptionModel{}.Types(), map[string]attr.Value{
+					"id":     types.StringValue("sub_2"),
+					"status": types.StringValue("past_due"),
+				}),
+			}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &CustomerSubscriptionsDataSource{}
+			var model CustomerSubscriptionsDataSourceModel
+			diags := diag.Diagnostics{}
+			d.populateModel(context.Background(), &model, tc.in, &diags)
+			assert.False(t, diags.HasError())
+
+			if !assert.Equal(t, tc.want, model.Subscriptions) {
+				t.Errorf("unexpected result for Subscriptions: %v", model.Subscriptions)
+			}
+		})
+	}
+}
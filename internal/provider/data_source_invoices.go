@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InvoicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &InvoicesDataSource{}
+
+func NewInvoicesDataSource() datasource.DataSource {
+	return &InvoicesDataSource{}
+}
+
+// InvoicesDataSource defines the data source implementation.
+type InvoicesDataSource struct {
+	sc *client.API
+}
+
+// InvoicesDataSourceModel describes the data source data model.
+type InvoicesDataSourceModel struct {
+	Created      types.Object `tfsdk:"created"`
+	Customer     types.String `tfsdk:"customer"`
+	Invoices     types.List   `tfsdk:"invoices"`
+	Status       types.String `tfsdk:"status"`
+	Subscription types.String `tfsdk:"subscription"`
+}
+
+type InvoiceModel struct {
+	Id           types.String `tfsdk:"id"`
+	AmountDue    types.Int64  `tfsdk:"amount_due"`
+	AmountPaid   types.Int64  `tfsdk:"amount_paid"`
+	Created      types.Int64  `tfsdk:"created"`
+	Currency     types.String `tfsdk:"currency"`
+	Customer     types.String `tfsdk:"customer"`
+	Number       types.String `tfsdk:"number"`
+	Status       types.String `tfsdk:"status"`
+	Subscription types.String `tfsdk:"subscription"`
+	Total        types.Int64  `tfsdk:"total"`
+}
+
+func (m InvoiceModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"amount_due":   types.Int64Type,
+		"amount_paid":  types.Int64Type,
+		"created":      types.Int64Type,
+		"currency":     types.StringType,
+		"customer":     types.StringType,
+		"number":       types.StringType,
+		"status":       types.StringType,
+		"subscription": types.StringType,
+		"total":        types.Int64Type,
+	}
+}
+
+func (d *InvoicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoices"
+}
+
+func (d *InvoicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the invoices of the account, most recently created first.",
+
+		Attributes: map[string]schema.Attribute{
+			"created": schema.SingleNestedAttribute{
+				MarkdownDescription: "Only list invoices created within this range. Bounds are measured in seconds since the Unix epoch, see the `timestamp` function.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"gt": schema.Int64Attribute{
+						MarkdownDescription: "Only list invoices created after this time.",
+						Optional:            true,
+					},
+					"gte": schema.Int64Attribute{
+						MarkdownDescription: "Only list invoices created at or after this time.",
+						Optional:            true,
+					},
+					"lt": schema.Int64Attribute{
+						MarkdownDescription: "Only list invoices created before this time.",
+						Optional:            true,
+					},
+					"lte": schema.Int64Attribute{
+						MarkdownDescription: "Only list invoices created at or before this time.",
+						Optional:            true,
+					},
+				},
+			},
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "Only list invoices of the customer with this ID.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only list invoices with this status. Defaults to all invoices.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"draft",
+						"open",
+						"paid",
+						"uncollectible",
+						"void",
+					),
+				},
+			},
+			"subscription": schema.StringAttribute{
+				MarkdownDescription: "Only list invoices of the subscription with this ID.",
+				Optional:            true,
+			},
+			"invoices": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching invoices.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier for the invoice.",
+							Computed:            true,
+						},
+						"amount_due": schema.Int64Attribute{
+							MarkdownDescription: "Final amount due at this time for this invoice, in the smallest currency unit.",
+							Computed:            true,
+						},
+						"amount_paid": schema.Int64Attribute{
+							MarkdownDescription: "The amount, in the smallest currency unit, that was paid.",
+							Computed:            true,
+						},
+						"created": schema.Int64Attribute{
+							MarkdownDescription: "Time at which the invoice was created. Measured in seconds since the Unix epoch.",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Three-letter ISO currency code, in lowercase.",
+							Computed:            true,
+						},
+						"customer": schema.StringAttribute{
+							MarkdownDescription: "The ID of the customer who will be billed.",
+							Computed:            true,
+						},
+						"number": schema.StringAttribute{
+							MarkdownDescription: "A unique, identifying string that appears on emails sent to the customer for this invoice. Not set for drafts.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the invoice, one of `draft`, `open`, `paid`, `uncollectible`, or `void`.",
+							Computed:            true,
+						},
+						"subscription": schema.StringAttribute{
+							MarkdownDescription: "The ID of the subscription this invoice was prepared for, if any.",
+							Computed:            true,
+						},
+						"total": schema.Int64Attribute{
+							MarkdownDescription: "Total after discounts and taxes, in the smallest currency unit.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InvoicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *InvoicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InvoicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := d.buildParams(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var invoices []*stripe.Invoice
+	iter := d.sc.Invoices.List(params)
+	for iter.Next() {
+		invoices = append(invoices, iter.Invoice())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list invoices, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, invoices, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *InvoicesDataSource) buildParams(ctx context.Context, model InvoicesDataSourceModel, respDiag *diag.Diagnostics) *stripe.InvoiceListParams {
+	params := &stripe.InvoiceListParams{}
+	params.CreatedRange = rangeQueryParams(ctx, model.Created, respDiag)
+	if !model.Customer.IsNull() {
+		params.Customer = model.Customer.ValueStringPointer()
+	}
+	if !model.Status.IsNull() {
+		params.Status = model.Status.ValueStringPointer()
+	}
+	if !model.Subscription.IsNull() {
+		params.Subscription = model.Subscription.ValueStringPointer()
+	}
+	return params
+}
+
+func (d *InvoicesDataSource) populateModel(ctx context.Context, model *InvoicesDataSourceModel, invoices []*stripe.Invoice, respDiag *diag.Diagnostics) {
+	items := make([]InvoiceModel, 0, len(invoices))
+	for _, invoice := range invoices {
+		item := InvoiceModel{
+			Id:           types.StringValue(invoice.ID),
+			AmountDue:    types.Int64Value(invoice.AmountDue),
+			AmountPaid:   types.Int64Value(invoice.AmountPaid),
+			Created:      types.Int64Value(invoice.Created),
+			Currency:     types.StringValue(string(invoice.Currency)),
+			Customer:     types.StringNull(),
+			Number:       StringNullIfEmpty(invoice.Number),
+			Status:       types.StringValue(string(invoice.Status)),
+			Subscription: types.StringNull(),
+			Total:        types.Int64Value(invoice.Total),
+		}
+		if invoice.Customer != nil {
+			item.Customer = types.StringValue(invoice.Customer.ID)
+		}
+		if invoice.Subscription != nil {
+			item.Subscription = types.StringValue(invoice.Subscription.ID)
+		}
+		items = append(items, item)
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: InvoiceModel{}.Types(),
+	}, items)
+	respDiag.Append(diags...)
+	model.Invoices = list
+}
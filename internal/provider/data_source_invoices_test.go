@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestBuildParamsInvoicesDataSource(t *testing.T) {
+	cases := []struct {
+		name  string
+		model InvoicesDataSourceModel
+		want  *stripe.InvoiceListParams
+	}{
+		{
+			name: "No filters",
+			model: InvoicesDataSourceModel{
+				Created:      types.ObjectNull(RangeQueryModel{}.Types()),
+				Customer:     types.StringNull(),
+				Status:       types.StringNull(),
+				Subscription: types.StringNull(),
+			},
+			want: &stripe.InvoiceListParams{},
+		},
+		{
+			name: "All filters",
+			model: InvoicesDataSourceModel{
+				Created: types.ObjectValueMust(RangeQueryModel{}.Types(), map[string]attr.Value{
+					"gt":  types.Int64Null(),
+					"gte": types.Int64Value(1700000000),
+					"lt":  types.Int64Value(1710000000),
+					"lte": types.Int64Null(),
+				}),
+				Customer:     types.StringValue("cus_123"),
+				Status:       types.StringValue("open"),
+				Subscription: types.StringValue("sub_123"),
+			},
+			want: &stripe.InvoiceListParams{
+				CreatedRange: &stripe.RangeQueryParams{
+					GreaterThanOrEqual: 1700000000,
+					LesserThan:         1710000000,
+				},
+				Customer:     stripe.String("cus_123"),
+				Status:       stripe.String("open"),
+				Subscription: stripe.String("sub_123"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &InvoicesDataSource{}
+			diags := diag.Diagnostics{}
+			assert.Equal(t, tc.want, d.buildParams(context.Background(), tc.model, &diags))
+			assert.False(t, diags.HasError())
+		})
+	}
+}
+
+func TestPopulateModelInvoicesDataSource(t *testing.T) {
+	d := &InvoicesDataSource{}
+	var model InvoicesDataSourceModel
+	diags := diag.Diagnostics{}
+	d.populateModel(context.Background(), &model, []*stripe.Invoice{
+		{
+			ID:           "in_1",
+			AmountDue:    1000,
+			AmountPaid:   1000,
+			Created:      1700000000,
+			Currency:     stripe.CurrencyUSD,
+			Customer:     &stripe.Customer{ID: "cus_123"},
+			Number:       "ABC123-0001",
+			Status:       stripe.InvoiceStatusPaid,
+			Subscription: &stripe.Subscription{ID: "sub_123"},
+			Total:        1000,
+		},
+		{
+			ID:       "in_2",
+			Created:  1700000100,
+			Currency: stripe.CurrencyUSD,
+			Status:   stripe.InvoiceStatusDraft,
+		},
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.ListValueMust(types.ObjectType{
+		AttrTypes: InvoiceModel{}.Types(),
+	}, []attr.Value{
+		types.ObjectValueMust(InvoiceModel{}.Types(), map[string]attr.Value{
+			"id":           types.StringValue("in_1"),
+			"amount_due":   types.Int64Value(1000),
+			"amount_paid":  types.Int64Value(1000),
+			"created":      types.Int64Value(1700000000),
+			"currency":     types.StringValue("usd"),
+			"customer":     types.StringValue("cus_123"),
+			"number":       types.StringValue("ABC123-0001"),
+			"status":       types.StringValue("paid"),
+			"subscription": types.StringValue("sub_123"),
+			"total":        types.Int64Value(1000),
+		}),
+		types.ObjectValueMust(InvoiceModel{}.Types(), map[string]attr.Value{
+			"id":           types.StringValue("in_2"),
+			"amount_due":   types.Int64Value(0),
+			"amount_paid":  types.Int64Value(0),
+			"created":      types.Int64Value(1700000100),
+			"currency":     types.StringValue("usd"),
+			"customer":     types.StringNull(),
+			"number":       types.StringNull(),
+			"status":       types.StringValue("draft"),
+			"subscription": types.StringNull(),
+			"total":        types.Int64Value(0),
+		}),
+	}), model.Invoices)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PricesDataSource{}
+var _ datasource.DataSourceWithConfigure = &PricesDataSource{}
+
+func NewPricesDataSource() datasource.DataSource {
+	return &PricesDataSource{}
+}
+
+// PricesDataSource defines the data source implementation.
+type PricesDataSource struct {
+	sc *client.API
+}
+
+// PricesDataSourceModel describes the data source data model.
+type PricesDataSourceModel struct {
+	Active  types.Bool   `tfsdk:"active"`
+	IDs     types.List   `tfsdk:"ids"`
+	Product types.String `tfsdk:"product"`
+}
+
+func (d *PricesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prices"
+}
+
+func (d *PricesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the IDs of the prices of the account, such as for generating `import` blocks for `stripe_price`.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list prices that are active (`true`) or archived (`false`). Defaults to all prices.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching prices, sorted so that they do not reorder as prices are created.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"product": schema.StringAttribute{
+				MarkdownDescription: "Only list prices of the product with this ID. Defaults to the prices of all products.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *PricesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *PricesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PricesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prices []*stripe.Price
+	iter := d.sc.Prices.List(d.buildParams(data))
+	for iter.Next() {
+		prices = append(prices, iter.Price())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list prices, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, prices, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *PricesDataSource) buildParams(model PricesDataSourceModel) *stripe.PriceListParams {
+	params := &stripe.PriceListParams{}
+	if !model.Active.IsNull() {
+		params.Active = model.Active.ValueBoolPointer()
+	}
+	if !model.Product.IsNull() {
+		params.Product = model.Product.ValueStringPointer()
+	}
+	return params
+}
+
+func (d *PricesDataSource) populateModel(ctx context.Context, model *PricesDataSourceModel, prices []*stripe.Price, respDiag *diag.Diagnostics) {
+	ids := make([]string, 0, len(prices))
+	for _, price := range prices {
+		ids = append(ids, price.ID)
+	}
+	// Stripe lists the newest first, which would shift every ID when a
+	// price is created.
+	slices.Sort(ids)
+	list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	respDiag.Append(diags...)
+	model.IDs = list
+}
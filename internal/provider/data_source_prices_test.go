@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestBuildParamsPricesDataSource(t *testing.T) {
+	d := &PricesDataSource{}
+	assert.Equal(t, &stripe.PriceListParams{}, d.buildParams(PricesDataSourceModel{
+		Active:  types.BoolNull(),
+		Product: types.StringNull(),
+	}))
+	assert.Equal(t, &stripe.PriceListParams{
+		Active:  stripe.Bool(true),
+		Product: stripe.String("prod_123"),
+	}, d.buildParams(PricesDataSourceModel{
+		Active:  types.BoolValue(true),
+		Product: types.StringValue("prod_123"),
+	}))
+}
+
+func TestPopulateModelPricesDataSource(t *testing.T) {
+	d := &PricesDataSource{}
+	var model PricesDataSourceModel
+	diags := diag.Diagnostics{}
+	d.populateModel(context.Background(), &model, []*stripe.Price{
+		{ID: "price_2"},
+		{ID: "price_1"},
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, testListValue(t, types.StringType, []string{"price_1", "price_2"}), model.IDs)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProductDefaultPriceDataSource{}
+var _ datasource.DataSourceWithConfigure = &ProductDefaultPriceDataSource{}
+
+func NewProductDefaultPriceDataSource() datasource.DataSource {
+	return &ProductDefaultPriceDataSource{}
+}
+
+// ProductDefaultPriceDataSource defines the data source implementation.
+type ProductDefaultPriceDataSource struct {
+	sc *client.API
+}
+
+// ProductDefaultPriceDataSourceModel describes the data source data model.
+type ProductDefaultPriceDataSourceModel struct {
+	Id                types.String  `tfsdk:"id"`
+	Active            types.Bool    `tfsdk:"active"`
+	BillingScheme     types.String  `tfsdk:"billing_scheme"`
+	Created           types.Int64   `tfsdk:"created"`
+	Currency          types.String  `tfsdk:"currency"`
+	LookupKey         types.String  `tfsdk:"lookup_key"`
+	Metadata          types.Map     `tfsdk:"metadata"`
+	Nickname          types.String  `tfsdk:"nickname"`
+	Product           types.String  `tfsdk:"product"`
+	Recurring         types.Object  `tfsdk:"recurring"`
+	TaxBehavior       types.String  `tfsdk:"tax_behavior"`
+	TiersMode         types.String  `tfsdk:"tiers_mode"`
+	Type              types.String  `tfsdk:"type"`
+	UnitAmount        types.Int64   `tfsdk:"unit_amount"`
+	UnitAmountDecimal types.Float64 `tfsdk:"unit_amount_decimal"`
+}
+
+type ProductDefaultPriceRecurringModel struct {
+	Interval       types.String `tfsdk:"interval"`
+	AggregateUsage types.String `tfsdk:"aggregate_usage"`
+	IntervalCount  types.Int64  `tfsdk:"interval_count"`
+	Meter          types.String `tfsdk:"meter"`
+	UsageType      types.String `tfsdk:"usage_type"`
+}
+
+func (m ProductDefaultPriceRecurringModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interval":        types.StringType,
+		"aggregate_usage": types.StringType,
+		"interval_count":  types.Int64Type,
+		"meter":           types.StringType,
+		"usage_type":      types.StringType,
+	}
+}
+
+func (d *ProductDefaultPriceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_default_price"
+}
+
+func (d *ProductDefaultPriceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up the default price of a product.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the default price.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the price can be used for new purchases.",
+				Computed:            true,
+			},
+			"billing_scheme": schema.StringAttribute{
+				MarkdownDescription: "Describes how to compute the price per period. Either `per_unit` or `tiered`.",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Time at which the object was created. Measured in seconds since the Unix epoch.",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "Three-letter ISO currency code, in lowercase.",
+				Computed:            true,
+			},
+			"lookup_key": schema.StringAttribute{
+				MarkdownDescription: "A lookup key used to retrieve prices dynamically from a static string.",
+				Computed:            true,
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "Set of key-value pairs attached to the price.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"nickname": schema.StringAttribute{
+				MarkdownDescription: "A brief description of the price, hidden from customers.",
+				Computed:            true,
+			},
+			"product": schema.StringAttribute{
+				MarkdownDescription: "The ID of the product whose default price will be looked up.",
+				Required:            true,
+			},
+			"recurring": schema.SingleNestedAttribute{
+				MarkdownDescription: "The recurring components of the price. Null for one-time prices.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.StringAttribute{
+						MarkdownDescription: "The frequency at which a subscription is billed. One of `day`, `week`, `month` or `year`.",
+						Computed:            true,
+					},
+					"aggregate_usage": schema.StringAttribute{
+						MarkdownDescription: "Specifies a usage aggregation strategy for prices of `usage_type=metered`.",
+						Computed:            true,
+					},
+					"interval_count": schema.Int64Attribute{
+						MarkdownDescription: "The number of intervals between subscription billings.",
+						Computed:            true,
+					},
+					"meter": schema.StringAttribute{
+						MarkdownDescription: "The meter tracking the usage of a metered price.",
+						Computed:            true,
+					},
+					"usage_type": schema.StringAttribute{
+						MarkdownDescription: "Configures how the quantity per period should be determined.",
+						Computed:            true,
+					},
+				},
+			},
+			"tax_behavior": schema.StringAttribute{
+				MarkdownDescription: "Specifies whether the price is considered inclusive of taxes or exclusive of taxes.",
+				Computed:            true,
+			},
+			"tiers_mode": schema.StringAttribute{
+				MarkdownDescription: "Defines if the tiering price should be `graduated` or `volume` based.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "One of `one_time` or `recurring` depending on whether the price is for a one-time purchase or a recurring (subscription) purchase.",
+				Computed:            true,
+			},
+			"unit_amount": schema.Int64Attribute{
+				MarkdownDescription: "The unit amount in cents to be charged, represented as a whole integer if possible.",
+				Computed:            true,
+			},
+			"unit_amount_decimal": schema.Float64Attribute{
+				MarkdownDescription: "The unit amount in cents to be charged, represented as a decimal with at most 12 decimal places.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProductDefaultPriceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *ProductDefaultPriceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProductDefaultPriceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &stripe.ProductParams{}
+	params.AddExpand("default_price")
+	product, err := d.sc.Products.Get(data.Product.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	if product.DefaultPrice == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("product"),
+			"Missing default price",
+			fmt.Sprintf("Product %s has no default price.", product.ID),
+		)
+		return
+	}
+
+	d.populateModel(ctx, &data, product.DefaultPrice, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ProductDefaultPriceDataSource) populateModel(ctx context.Context, model *ProductDefaultPriceDataSourceModel, price *stripe.Price, respDiag *diag.Diagnostics) {
+	model.Id = types.StringValue(price.ID)
+	model.Active = types.BoolValue(price.Active)
+	model.BillingScheme = StringNullIfEmpty(string(price.BillingScheme))
+	model.Created = Int64NullIfEmpty(price.Created)
+	model.Currency = StringNullIfEmpty(string(price.Currency))
+	model.LookupKey = StringNullIfEmpty(price.LookupKey)
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, price.Metadata)
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.Nickname = StringNullIfEmpty(price.Nickname)
+	if price.Recurring != nil {
+		recurring, diags := types.ObjectValueFrom(ctx, ProductDefaultPriceRecurringModel{}.Types(), ProductDefaultPriceRecurringModel{
+			Interval:       StringNullIfEmpty(string(price.Recurring.Interval)),
+			AggregateUsage: StringNullIfEmpty(string(price.Recurring.AggregateUsage)),
+			IntervalCount:  Int64NullIfEmpty(price.Recurring.IntervalCount),
+			Meter:          StringNullIfEmpty(price.Recurring.Meter),
+			UsageType:      StringNullIfEmpty(string(price.Recurring.UsageType)),
+		})
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		model.Recurring = recurring
+	} else {
+		model.Recurring = types.ObjectNull(ProductDefaultPriceRecurringModel{}.Types())
+	}
+	model.TaxBehavior = StringNullIfEmpty(string(price.TaxBehavior))
+	model.TiersMode = StringNullIfEmpty(string(price.TiersMode))
+	model.Type = StringNullIfEmpty(string(price.Type))
+	model.UnitAmount = Int64NullIfEmpty(price.UnitAmount)
+	model.UnitAmountDecimal = Float64NullIfEmpty(price.UnitAmountDecimal)
+}
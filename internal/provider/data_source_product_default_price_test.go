@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestPopulateModelProductDefaultPriceDataSource(t *testing.T) {
+	cases := []struct {
+		name string
+		in   *stripe.Price
+		want ProductDefaultPriceDataSourceModel
+	}{
+		{
+			name: "One-time price",
+			in: &stripe.Price{
+				ID:            "price_123",
+				Active:        true,
+				BillingScheme: stripe.PriceBillingSchemePerUnit,
+				Currency:      stripe.CurrencyUSD,
+				TaxBehavior:   stripe.PriceTaxBehaviorUnspecified,
+				Type:          stripe.PriceTypeOneTime,
+				UnitAmount:    1000,
+			},
+			want: ProductDefaultPriceDataSourceModel{
+				Id:                types.StringValue("price_123"),
+				Active:            types.BoolValue(true),
+				BillingScheme:     types.StringValue("per_unit"),
+				Created:           types.Int64Null(),
+				Currency:          types.StringValue("usd"),
+				LookupKey:         types.StringNull(),
+				Metadata:          types.MapNull(types.StringType),
+				Nickname:          types.StringNull(),
+				Recurring:         types.ObjectNull(ProductDefaultPriceRecurringModel{}.Types()),
+				TaxBehavior:       types.StringValue("unspecified"),
+				TiersMode:         types.StringNull(),
+				Type:              types.StringValue("one_time"),
+				UnitAmount:        types.Int64Value(1000),
+				UnitAmountDecimal: types.Float64Null(),
+			},
+		},
+		{
+			name: "Recurring price",
+			in: &stripe.Price{
+				ID:            "price_456",
+				Active:        true,
+				BillingScheme: stripe.PriceBillingSchemePerUnit,
+				Created:       1700000000,
+				Currency:      stripe.CurrencyEUR,
+				LookupKey:     "standard_monthly",
+				Metadata: map[string]string{
+					"plan": "standard",
+				},
+				Nickname: "Standard",
+				Recurring: &stripe.PriceRecurring{
+					Interval:      stripe.PriceRecurringIntervalMonth,
+					IntervalCount: 1,
+					UsageType:     stripe.PriceRecurringUsageTypeLicensed,
+				},
+				TaxBehavior:       stripe.PriceTaxBehaviorExclusive,
+				Type:              stripe.PriceTypeRecurring,
+				UnitAmount:        1500,
+				UnitAmountDecimal: 1500,
+			},
+			want: ProductDefaultPriceDataSourceModel{
+				Id:            types.StringValue("price_456"),
+				Active:        types.BoolValue(true),
+				BillingScheme: types.StringValue("per_unit"),
+				Created:       types.Int64Value(1700000000),
+				Currency:      types.StringValue("eur"),
+				LookupKey:     types.StringValue("standard_monthly"),
+				Metadata:      types.MapValueMust(types.StringType, map[string]attr.Value{"plan": types.StringValue("standard")}),
+				Nickname:      types.StringValue("Standard"),
+				Recurring: types.ObjectValueMust(ProductDefaultPriceRecurringModel{}.Types(), map[string]attr.Value{
+					"interval":        types.StringValue("month"),
+					"aggregate_usage": types.StringNull(),
+					"interval_count":  types.Int64Value(1),
+					"meter":           types.StringNull(),
+					"usage_type":      types.StringValue("licensed"),
+				}),
+				TaxBehavior:       types.StringValue("exclusive"),
+				TiersMode:         types.StringNull(),
+				Type:              types.StringValue("recurring"),
+				UnitAmount:        types.Int64Value(1500),
+				UnitAmountDecimal: types.Float64Value(1500),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &ProductDefaultPriceDataSource{}
+			model := ProductDefaultPriceDataSourceModel{
+				Product: types.StringValue("prod_123"),
+			}
+			diags := diag.Diagnostics{}
+			d.populateModel(context.Background(), &model, tc.in, &diags)
+			assert.False(t, diags.HasError())
+
+			tc.want.Product = types.StringValue("prod_123")
+			if !assert.Equal(t, tc.want, model) {
+				t.Errorf("unexpected result for model: %v", model)
+			}
+		})
+	}
+}
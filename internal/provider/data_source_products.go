@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProductsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ProductsDataSource{}
+
+func NewProductsDataSource() datasource.DataSource {
+	return &ProductsDataSource{}
+}
+
+// ProductsDataSource defines the data source implementation.
+type ProductsDataSource struct {
+	sc *client.API
+}
+
+// ProductsDataSourceModel describes the data source data model.
+type ProductsDataSourceModel struct {
+	Active types.Bool `tfsdk:"active"`
+	IDs    types.List `tfsdk:"ids"`
+}
+
+func (d *ProductsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_products"
+}
+
+func (d *ProductsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the IDs of the products of the account, such as for generating `import` blocks for `stripe_product`.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list products that are active (`true`) or archived (`false`). Defaults to all products.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the matching products, sorted so that they do not reorder as products are created.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ProductsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *ProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProductsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var products []*stripe.Product
+	iter := d.sc.Products.List(d.buildParams(data))
+	for iter.Next() {
+		products = append(products, iter.Product())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list products, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, products, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ProductsDataSource) buildParams(model ProductsDataSourceModel) *stripe.ProductListParams {
+	params := &stripe.ProductListParams{}
+	if !model.Active.IsNull() {
+		params.Active = model.Active.ValueBoolPointer()
+	}
+	return params
+}
+
+func (d *ProductsDataSource) populateModel(ctx context.Context, model *ProductsDataSourceModel, products []*stripe.Product, respDiag *diag.Diagnostics) {
+	ids := make([]string, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+	// Stripe lists the newest first, which would shift every ID when a
+	// product is created.
+	slices.Sort(ids)
+	list, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	respDiag.Append(diags...)
+	model.IDs = list
+}
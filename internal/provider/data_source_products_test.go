@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestBuildParamsProductsDataSource(t *testing.T) {
+	d := &ProductsDataSource{}
+	assert.Equal(t, &stripe.ProductListParams{}, d.buildParams(ProductsDataSourceModel{
+		Active: types.BoolNull(),
+	}))
+	assert.Equal(t, &stripe.ProductListParams{
+		Active: stripe.Bool(false),
+	}, d.buildParams(ProductsDataSourceModel{
+		Active: types.BoolValue(false),
+	}))
+}
+
+func TestPopulateModelProductsDataSource(t *testing.T) {
+	d := &ProductsDataSource{}
+	var model ProductsDataSourceModel
+	diags := diag.Diagnostics{}
+	d.populateModel(context.Background(), &model, []*stripe.Product{
+		{ID: "prod_C"},
+		{ID: "prod_A"},
+		{ID: "prod_B"},
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, testListValue(t, types.StringType, []string{"prod_A", "prod_B", "prod_C"}), model.IDs)
+
+	d.populateModel(context.Background(), &model, nil, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, testListValue(t, types.StringType, []string{}), model.IDs)
+}
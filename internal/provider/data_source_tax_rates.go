@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TaxRatesDataSource{}
+var _ datasource.DataSourceWithConfigure = &TaxRatesDataSource{}
+
+func NewTaxRatesDataSource() datasource.DataSource {
+	return &TaxRatesDataSource{}
+}
+
+// TaxRatesDataSource defines the data source implementation.
+type TaxRatesDataSource struct {
+	sc *client.API
+}
+
+// TaxRatesDataSourceModel describes the data source data model.
+type TaxRatesDataSourceModel struct {
+	Active    types.Bool `tfsdk:"active"`
+	Inclusive types.Bool `tfsdk:"inclusive"`
+	TaxRates  types.List `tfsdk:"tax_rates"`
+}
+
+type TaxRateModel struct {
+	Id           types.String  `tfsdk:"id"`
+	Active       types.Bool    `tfsdk:"active"`
+	Country      types.String  `tfsdk:"country"`
+	Description  types.String  `tfsdk:"description"`
+	DisplayName  types.String  `tfsdk:"display_name"`
+	Inclusive    types.Bool    `tfsdk:"inclusive"`
+	Jurisdiction types.String  `tfsdk:"jurisdiction"`
+	Percentage   types.Float64 `tfsdk:"percentage"`
+	State        types.String  `tfsdk:"state"`
+	TaxType      types.String  `tfsdk:"tax_type"`
+}
+
+func (m TaxRateModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"active":       types.BoolType,
+		"country":      types.StringType,
+		"description":  types.StringType,
+		"display_name": types.StringType,
+		"inclusive":    types.BoolType,
+		"jurisdiction": types.StringType,
+		"percentage":   types.Float64Type,
+		"state":        types.StringType,
+		"tax_type":     types.StringType,
+	}
+}
+
+func (d *TaxRatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_rates"
+}
+
+func (d *TaxRatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the tax rates of the account, most recently created first.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list tax rates that are active (`true`) or archived (`false`). Defaults to all tax rates.",
+				Optional:            true,
+			},
+			"inclusive": schema.BoolAttribute{
+				MarkdownDescription: "Only list tax rates that are inclusive (`true`) or exclusive (`false`). Defaults to all tax rates.",
+				Optional:            true,
+			},
+			"tax_rates": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching tax rates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier for the tax rate.",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tax rate can be used with new applications.",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "Two-letter country code (ISO 3166-1 alpha-2).",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "An arbitrary string attached to the tax rate for internal use.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the tax rate as it appears to customers.",
+							Computed:            true,
+						},
+						"inclusive": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tax rate is inclusive or exclusive.",
+							Computed:            true,
+						},
+						"jurisdiction": schema.StringAttribute{
+							MarkdownDescription: "The jurisdiction for the tax rate.",
+							Computed:            true,
+						},
+						"percentage": schema.Float64Attribute{
+							MarkdownDescription: "Tax rate percentage out of 100.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "ISO 3166-2 subdivision code, without country prefix.",
+							Computed:            true,
+						},
+						"tax_type": schema.StringAttribute{
+							MarkdownDescription: "The high-level tax type, such as `vat` or `sales_tax`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TaxRatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.sc = providerData.Client
+}
+
+func (d *TaxRatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TaxRatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var taxRates []*stripe.TaxRate
+	iter := d.sc.TaxRates.List(d.buildParams(data))
+	for iter.Next() {
+		taxRates = append(taxRates, iter.TaxRate())
+	}
+	if err := iter.Err(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tax rates, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	d.populateModel(ctx, &data, taxRates, &resp.Diagnostics)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *TaxRatesDataSource) buildParams(model TaxRatesDataSourceModel) *stripe.TaxRateListParams {
+	params := &stripe.TaxRateListParams{}
+	if !model.Active.IsNull() {
+		params.Active = model.Active.ValueBoolPointer()
+	}
+	if !model.Inclusive.IsNull() {
+		params.Inclusive = model.Inclusive.ValueBoolPointer()
+	}
+	return params
+}
+
+func (d *TaxRatesDataSource) populateModel(ctx context.Context, model *TaxRatesDataSourceModel, taxRates []*stripe.TaxRate, respDiag *diag.Diagnostics) {
+	items := make([]TaxRateModel, 0, len(taxRates))
+	for _, taxRate := range taxRates {
+		items = append(items, TaxRateModel{
+			Id:           types.StringValue(taxRate.ID),
+			Active:       types.BoolValue(taxRate.Active),
+			Country:      StringNullIfEmpty(taxRate.Country),
+			Description:  StringNullIfEmpty(taxRate.Description),
+			DisplayName:  types.StringValue(taxRate.DisplayName),
+			Inclusive:    types.BoolValue(taxRate.Inclusive),
+			Jurisdiction: StringNullIfEmpty(taxRate.Jurisdiction),
+			Percentage:   types.Float64Value(taxRate.Percentage),
+			State:        StringNullIfEmpty(taxRate.State),
+			TaxType:      StringNullIfEmpty(string(taxRate.TaxType)),
+		})
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: TaxRateModel{}.Types(),
+	}, items)
+	respDiag.Append(diags...)
+	model.TaxRates = list
+}
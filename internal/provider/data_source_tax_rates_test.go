@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stripe/stripe-go/v81"
+)
+
+func TestBuildParamsTaxRatesDataSource(t *testing.T) {
+	cases := []struct {
+		name  string
+		model TaxRatesDataSourceModel
+		want  *stripe.TaxRateListParams
+	}{
+		{
+			name: "No filters",
+			model: TaxRatesDataSourceModel{
+				Active:    types.BoolNull(),
+				Inclusive: types.BoolNull(),
+			},
+			want: &stripe.TaxRateListParams{},
+		},
+		{
+			name: "Active only",
+			model: TaxRatesDataSourceModel{
+				Active:    types.BoolValue(true),
+				Inclusive: types.BoolNull(),
+			},
+			want: &stripe.TaxRateListParams{
+				Active: stripe.Bool(true),
+			},
+		},
+		{
+			name: "Both filters",
+			model: TaxRatesDataSourceModel{
+				Active:    types.BoolValue(false),
+				Inclusive: types.BoolValue(true),
+			},
+			want: &stripe.TaxRateListParams{
+				Active:    stripe.Bool(false),
+				Inclusive: stripe.Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &TaxRatesDataSource{}
+			assert.Equal(t, tc.want, d.buildParams(tc.model))
+		})
+	}
+}
+
+func TestPopulateModelTaxRatesDataSource(t *testing.T) {
+	d := &TaxRatesDataSource{}
+	var model TaxRatesDataSourceModel
+	diags := diag.Diagnostics{}
+	d.populateModel(context.Background(), &model, []*stripe.TaxRate{
+		{
+			ID:           "txr_1",
+			Active:       true,
+			Country:      "DE",
+			DisplayName:  "VAT",
+			Inclusive:    true,
+			Jurisdiction: "DE",
+			Percentage:   19,
+			TaxType:      stripe.TaxRateTaxTypeVAT,
+		},
+	}, &diags)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, types.ListValueMust(types.ObjectType{
+		AttrTypes: TaxRateModel{}.Types(),
+	}, []attr.Value{
+		types.ObjectValueMust(TaxRateModel{}.Types(), map[string]attr.Value{
+			"id":           types.StringValue("txr_1"),
+			"active":       types.BoolValue(true),
+			"country":      types.StringValue("DE"),
+			"description":  types.StringNull(),
+			"display_name": types.StringValue("VAT"),
+			"inclusive":    types.BoolValue(true),
+			"jurisdiction": types.StringValue("DE"),
+			"percentage":   types.Float64Value(19),
+			"state":        types.StringNull(),
+			"tax_type":     types.StringValue("vat"),
+		}),
+	}), model.TaxRates)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CurrencyUpperFunction{}
+
+// currencyCodeRegexp matches a three-letter ISO currency code in any case.
+var currencyCodeRegexp = regexp.MustCompile(`^[A-Za-z]{3}$`)
+
+// lowercaseCurrencyRegexp matches a currency code the way Stripe stores it.
+var lowercaseCurrencyRegexp = regexp.MustCompile(`^[a-z]{3}$`)
+
+func NewCurrencyUpperFunction() function.Function {
+	return &CurrencyUpperFunction{}
+}
+
+// CurrencyUpperFunction defines the function implementation.
+type CurrencyUpperFunction struct{}
+
+func (f *CurrencyUpperFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "currency_upper"
+}
+
+func (f *CurrencyUpperFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Uppercase a currency code",
+		MarkdownDescription: "Returns the uppercase form of a three-letter ISO currency code for display. Stripe stores currencies in lowercase, so use the lowercase code in resource arguments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "code",
+				MarkdownDescription: "Three-letter ISO currency code, in any case.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CurrencyUpperFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &code))
+	if resp.Error != nil {
+		return
+	}
+
+	if !currencyCodeRegexp.MatchString(code) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a three-letter ISO currency code", code))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.ToUpper(code)))
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyUpperFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		want      types.String
+		expectErr bool
+	}{
+		{"lowercase", "usd", types.StringValue("USD"), false},
+		{"mixed case", "eUr", types.StringValue("EUR"), false},
+		{"uppercase", "GBP", types.StringValue("GBP"), false},
+		{"too long", "usdt", types.StringUnknown(), true},
+		{"not letters", "us1", types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &CurrencyUpperFunction{}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.code)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			f.Run(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectErr, resp.Error != nil)
+			assert.Equal(t, tt.want, resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GeneratePromoCodeFunction{}
+
+// promoCodeAlphabet holds the characters of generated promotion codes:
+// uppercase letters and digits without the easily confused 0, O, 1 and I.
+// Its 32 characters divide 256 evenly, so every character is equally likely.
+const promoCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// promoCodeMaxLength is the longest generated part of a promotion code.
+const promoCodeMaxLength = 64
+
+func NewGeneratePromoCodeFunction() function.Function {
+	return &GeneratePromoCodeFunction{}
+}
+
+// GeneratePromoCodeFunction defines the function implementation.
+type GeneratePromoCodeFunction struct{}
+
+func (f *GeneratePromoCodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "generate_promo_code"
+}
+
+func (f *GeneratePromoCodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Generate a promotion code",
+		MarkdownDescription: "Returns a human-friendly promotion code made of the uppercased prefix followed by uppercase letters and digits, excluding the ambiguous `0`, `O`, `1` and `I`. The same seed always generates the same code, so the result is stable across plans.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "Prefix of the code, such as `SUMMER-`. May be empty.",
+			},
+			function.Int64Parameter{
+				Name:                "length",
+				MarkdownDescription: fmt.Sprintf("Number of generated characters following the prefix, between 1 and %d.", promoCodeMaxLength),
+			},
+			function.StringParameter{
+				Name:                "seed",
+				MarkdownDescription: "Seed the code is derived from.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *GeneratePromoCodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, seed string
+	var length int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &length, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	if length < 1 || length > promoCodeMaxLength {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("length must be between 1 and %d, got %d", promoCodeMaxLength, length))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.ToUpper(prefix)+generatePromoCode(int(length), seed)))
+}
+
+// generatePromoCode derives length characters of promoCodeAlphabet from seed.
+// Each block of 32 characters comes from the SHA-256 digest of the seed and
+// the block number.
+func generatePromoCode(length int, seed string) string {
+	var code strings.Builder
+	for block := uint64(0); code.Len() < length; block++ {
+		h := sha256.New()
+		h.Write([]byte(seed))
+		_ = binary.Write(h, binary.BigEndian, block)
+		for _, b := range h.Sum(nil) {
+			if code.Len() == length {
+				break
+			}
+			code.WriteByte(promoCodeAlphabet[int(b)%len(promoCodeAlphabet)])
+		}
+	}
+	return code.String()
+}
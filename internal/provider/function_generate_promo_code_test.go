@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testGeneratePromoCode(prefix string, length int64, seed string) (types.String, *function.FuncError) {
+	f := &GeneratePromoCodeFunction{}
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(prefix),
+			types.Int64Value(length),
+			types.StringValue(seed),
+		}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value().(types.String), resp.Error
+}
+
+func TestGeneratePromoCodeFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    string
+		length    int64
+		wantLen   int
+		expectErr bool
+	}{
+		{"no prefix", "", 8, 8, false},
+		{"prefix uppercased", "summer-", 6, 13, false},
+		{"longer than a digest", "", 40, 40, false},
+		{"zero length", "", 0, 0, true},
+		{"too long", "", promoCodeMaxLength + 1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testGeneratePromoCode(tt.prefix, tt.length, "seed")
+
+			assert.Equal(t, tt.expectErr, err != nil)
+			if tt.expectErr {
+				assert.True(t, got.IsUnknown())
+				return
+			}
+			code := got.ValueString()
+			assert.Len(t, code, tt.wantLen)
+			assert.True(t, strings.HasPrefix(code, strings.ToUpper(tt.prefix)))
+		})
+	}
+}
+
+func TestGeneratePromoCodeFunctionDeterministic(t *testing.T) {
+	first, _ := testGeneratePromoCode("VIP", 10, "customer-123")
+	second, _ := testGeneratePromoCode("VIP", 10, "customer-123")
+	other, _ := testGeneratePromoCode("VIP", 10, "customer-456")
+
+	assert.Equal(t, first, second)
+	assert.NotEqual(t, first, other)
+
+	// A shorter code is a prefix of a longer one with the same seed.
+	short, _ := testGeneratePromoCode("VIP", 4, "customer-123")
+	assert.True(t, strings.HasPrefix(first.ValueString(), short.ValueString()))
+}
+
+func TestGeneratePromoCodeExcludesAmbiguousCharacters(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		code := generatePromoCode(promoCodeMaxLength, strings.Repeat("x", i))
+		assert.NotContainsf(t, code, "0", "code %q", code)
+		assert.NotContainsf(t, code, "O", "code %q", code)
+		assert.NotContainsf(t, code, "1", "code %q", code)
+		assert.NotContainsf(t, code, "I", "code %q", code)
+		assert.Equal(t, strings.ToUpper(code), code)
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/stripe/stripe-go/v81"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IsValidEventFunction{}
+
+// webhookEventTypes lists the event types known to the Stripe library the
+// provider is built with.
+var webhookEventTypes = []stripe.EventType{
+	stripe.EventTypeAccountApplicationAuthorized,
+	stripe.EventTypeAccountApplicationDeauthorized,
+	stripe.EventTypeAccountExternalAccountCreated,
+	stripe.EventTypeAccountExternalAccountDeleted,
+	stripe.EventTypeAccountExternalAccountUpdated,
+	stripe.EventTypeAccountUpdated,
+	stripe.EventTypeApplicationFeeCreated,
+	stripe.EventTypeApplicationFeeRefundUpdated,
+	stripe.EventTypeApplicationFeeRefunded,
+	stripe.EventTypeBalanceAvailable,
+	stripe.EventTypeBillingAlertTriggered,
+	stripe.EventTypeBillingPortalConfigurationCreated,
+	stripe.EventTypeBillingPortalConfigurationUpdated,
+	stripe.EventTypeBillingPortalSessionCreated,
+	stripe.EventTypeCapabilityUpdated,
+	stripe.EventTypeCashBalanceFundsAvailable,
+	stripe.EventTypeChargeCaptured,
+	stripe.EventTypeChargeDisputeClosed,
+	stripe.EventTypeChargeDisputeCreated,
+	stripe.EventTypeChargeDisputeFundsReinstated,
+	stripe.EventTypeChargeDisputeFundsWithdrawn,
+	stripe.EventTypeChargeDisputeUpdated,
+	stripe.EventTypeChargeExpired,
+	stripe.EventTypeChargeFailed,
+	stripe.EventTypeChargePending,
+	stripe.EventTypeChargeRefundUpdated,
+	stripe.EventTypeChargeRefunded,
+	stripe.EventTypeChargeSucceeded,
+	stripe.EventTypeChargeUpdated,
+	stripe.EventTypeCheckoutSessionAsyncPaymentFailed,
+	stripe.EventTypeCheckoutSessionAsyncPaymentSucceeded,
+	stripe.EventTypeCheckoutSessionCompleted,
+	stripe.EventTypeCheckoutSessionExpired,
+	stripe.EventTypeClimateOrderCanceled,
+	stripe.EventTypeClimateOrderCreated,
+	stripe.EventTypeClimateOrderDelayed,
+	stripe.EventTypeClimateOrderDelivered,
+	stripe.EventTypeClimateOrderProductSubstituted,
+	stripe.EventTypeClimateProductCreated,
+	stripe.EventTypeClimateProductPricingUpdated,
+	stripe.EventTypeCouponCreated,
+	stripe.EventTypeCouponDeleted,
+	stripe.EventTypeCouponUpdated,
+	stripe.EventTypeCreditNoteCreated,
+	stripe.EventTypeCreditNoteUpdated,
+	stripe.EventTypeCreditNoteVoided,
+	stripe.EventTypeCustomerCreated,
+	stripe.EventTypeCustomerDeleted,
+	stripe.EventTypeCustomerDiscountCreated,
+	stripe.EventTypeCustomerDiscountDeleted,
+	stripe.EventTypeCustomerDiscountUpdated,
+	stripe.EventTypeCustomerSourceCreated,
+	stripe.EventTypeCustomerSourceDeleted,
+	stripe.EventTypeCustomerSourceExpiring,
+	stripe.EventTypeCustomerSourceUpdated,
+	stripe.EventTypeCustomerSubscriptionCreated,
+	stripe.EventTypeCustomerSubscriptionDeleted,
+	stripe.EventTypeCustomerSubscriptionPaused,
+	stripe.EventTypeCustomerSubscriptionPendingUpdateApplied,
+	stripe.EventTypeCustomerSubscriptionPendingUpdateExpired,
+	stripe.EventTypeCustomerSubscriptionResumed,
+	stripe.EventTypeCustomerSubscriptionTrialWillEnd,
+	stripe.EventTypeCustomerSubscriptionUpdated,
+	stripe.EventTypeCustomerTaxIDCreated,
+	stripe.EventTypeCustomerTaxIDDeleted,
+	stripe.EventTypeCustomerTaxIDUpdated,
+	stripe.EventTypeCustomerUpdated,
+	stripe.EventTypeCustomerCashBalanceTransactionCreated,
+	stripe.EventTypeEntitlementsActiveEntitlementSummaryUpdated,
+	stripe.EventTypeFileCreated,
+	stripe.EventTypeFinancialConnectionsAccountCreated,
+	stripe.EventTypeFinancialConnectionsAccountDeactivated,
+	stripe.EventTypeFinancialConnectionsAccountDisconnected,
+	stripe.EventTypeFinancialConnectionsAccountReactivated,
+	stripe.EventTypeFinancialConnectionsAccountRefreshedBalance,
+	stripe.EventTypeFinancialConnectionsAccountRefreshedOwnership,
+	stripe.EventTypeFinancialConnectionsAccountRefreshedTransactions,
+	stripe.EventTypeIdentityVerificationSessionCanceled,
+	stripe.EventTypeIdentityVerificationSessionCreated,
+	stripe.EventTypeIdentityVerificationSessionProcessing,
+	stripe.EventTypeIdentityVerificationSessionRedacted,
+	stripe.EventTypeIdentityVerificationSessionRequiresInput,
+	stripe.EventTypeIdentityVerificationSessionVerified,
+	stripe.EventTypeInvoiceCreated,
+	stripe.EventTypeInvoiceDeleted,
+	stripe.EventTypeInvoiceFinalizationFailed,
+	stripe.EventTypeInvoiceFinalized,
+	stripe.EventTypeInvoiceMarkedUncollectible,
+	stripe.EventTypeInvoiceOverdue,
+	stripe.EventTypeInvoicePaid,
+	stripe.EventTypeInvoicePaymentActionRequired,
+	stripe.EventTypeInvoicePaymentFailed,
+	stripe.EventTypeInvoicePaymentSucceeded,
+	stripe.EventTypeInvoiceSent,
+	stripe.EventTypeInvoiceUpcoming,
+	stripe.EventTypeInvoiceUpdated,
+	stripe.EventTypeInvoiceVoided,
+	stripe.EventTypeInvoiceWillBeDue,
+	stripe.EventTypeInvoiceItemCreated,
+	stripe.EventTypeInvoiceItemDeleted,
+	stripe.EventTypeIssuingAuthorizationCreated,
+	stripe.EventTypeIssuingAuthorizationRequest,
+	stripe.EventTypeIssuingAuthorizationUpdated,
+	stripe.EventTypeIssuingCardCreated,
+	stripe.EventTypeIssuingCardUpdated,
+	stripe.EventTypeIssuingCardholderCreated,
+	stripe.EventTypeIssuingCardholderUpdated,
+	stripe.EventTypeIssuingDisputeClosed,
+	stripe.EventTypeIssuingDisputeCreated,
+	stripe.EventTypeIssuingDisputeFundsReinstated,
+	stripe.EventTypeIssuingDisputeFundsRescinded,
+	stripe.EventTypeIssuingDisputeSubmitted,
+	stripe.EventTypeIssuingDisputeUpdated,
+	stripe.EventTypeIssuingPersonalizationDesignActivated,
+	stripe.EventTypeIssuingPersonalizationDesignDeactivated,
+	stripe.EventTypeIssuingPersonalizationDesignRejected,
+	stripe.EventTypeIssuingPersonalizationDesignUpdated,
+	stripe.EventTypeIssuingTokenCreated,
+	stripe.EventTypeIssuingTokenUpdated,
+	stripe.EventTypeIssuingTransactionCreated,
+	stripe.EventTypeIssuingTransactionPurchaseDetailsReceiptUpdated,
+	stripe.EventTypeIssuingTransactionUpdated,
+	stripe.EventTypeMandateUpdated,
+	stripe.EventTypePaymentIntentAmountCapturableUpdated,
+	stripe.EventTypePaymentIntentCanceled,
+	stripe.EventTypePaymentIntentCreated,
+	stripe.EventTypePaymentIntentPartiallyFunded,
+	stripe.EventTypePaymentIntentPaymentFailed,
+	stripe.EventTypePaymentIntentProcessing,
+	stripe.EventTypePaymentIntentRequiresAction,
+	stripe.EventTypePaymentIntentSucceeded,
+	stripe.EventTypePaymentLinkCreated,
+	stripe.EventTypePaymentLinkUpdated,
+	stripe.EventTypePaymentMethodAttached,
+	stripe.EventTypePaymentMethodAutomaticallyUpdated,
+	stripe.EventTypePaymentMethodDetached,
+	stripe.EventTypePaymentMethodUpdated,
+	stripe.EventTypePayoutCanceled,
+	stripe.EventTypePayoutCreated,
+	stripe.EventTypePayoutFailed,
+	stripe.EventTypePayoutPaid,
+	stripe.EventTypePayoutReconciliationCompleted,
+	stripe.EventTypePayoutUpdated,
+	stripe.EventTypePersonCreated,
+	stripe.EventTypePersonDeleted,
+	stripe.EventTypePersonUpdated,
+	stripe.EventTypePlanCreated,
+	stripe.EventTypePlanDeleted,
+	stripe.EventTypePlanUpdated,
+	stripe.EventTypePriceCreated,
+	stripe.EventTypePriceDeleted,
+	stripe.EventTypePriceUpdated,
+	stripe.EventTypeProductCreated,
+	stripe.EventTypeProductDeleted,
+	stripe.EventTypeProductUpdated,
+	stripe.EventTypePromotionCodeCreated,
+	stripe.EventTypePromotionCodeUpdated,
+	stripe.EventTypeQuoteAccepted,
+	stripe.EventTypeQuoteCanceled,
+	stripe.EventTypeQuoteCreated,
+	stripe.EventTypeQuoteFinalized,
+	stripe.EventTypeRadarEarlyFraudWarningCreated,
+	stripe.EventTypeRadarEarlyFraudWarningUpdated,
+	stripe.EventTypeRefundCreated,
+	stripe.EventTypeRefundFailed,
+	stripe.EventTypeRefundUpdated,
+	stripe.EventTypeReportingReportRunFailed,
+	stripe.EventTypeReportingReportRunSucceeded,
+	stripe.EventTypeReportingReportTypeUpdated,
+	stripe.EventTypeReviewClosed,
+	stripe.EventTypeReviewOpened,
+	stripe.EventTypeSetupIntentCanceled,
+	stripe.EventTypeSetupIntentCreated,
+	stripe.EventTypeSetupIntentRequiresAction,
+	stripe.EventTypeSetupIntentSetupFailed,
+	stripe.EventTypeSetupIntentSucceeded,
+	stripe.EventTypeSigmaScheduledQueryRunCreated,
+	stripe.EventTypeSourceCanceled,
+	stripe.EventTypeSourceChargeable,
+	stripe.EventTypeSourceFailed,
+	stripe.EventTypeSourceMandateNotification,
+	stripe.EventTypeSourceRefundAttributesRequired,
+	stripe.EventTypeSourceTransactionCreated,
+	stripe.EventTypeSourceTransactionUpdated,
+	stripe.EventTypeSubscriptionScheduleAborted,
+	stripe.EventTypeSubscriptionScheduleCanceled,
+	stripe.EventTypeSubscriptionScheduleCompleted,
+	stripe.EventTypeSubscriptionScheduleCreated,
+	stripe.EventTypeSubscriptionScheduleExpiring,
+	stripe.EventTypeSubscriptionScheduleReleased,
+	stripe.EventTypeSubscriptionScheduleUpdated,
+	stripe.EventTypeTaxSettingsUpdated,
+	stripe.EventTypeTaxRateCreated,
+	stripe.EventTypeTaxRateUpdated,
+	stripe.EventTypeTerminalReaderActionFailed,
+	stripe.EventTypeTerminalReaderActionSucceeded,
+	stripe.EventTypeTestHelpersTestClockAdvancing,
+	stripe.EventTypeTestHelpersTestClockCreated,
+	stripe.EventTypeTestHelpersTestClockDeleted,
+	stripe.EventTypeTestHelpersTestClockInternalFailure,
+	stripe.EventTypeTestHelpersTestClockReady,
+	stripe.EventTypeTopupCanceled,
+	stripe.EventTypeTopupCreated,
+	stripe.EventTypeTopupFailed,
+	stripe.EventTypeTopupReversed,
+	stripe.EventTypeTopupSucceeded,
+	stripe.EventTypeTransferCreated,
+	stripe.EventTypeTransferReversed,
+	stripe.EventTypeTransferUpdated,
+	stripe.EventTypeTreasuryCreditReversalCreated,
+	stripe.EventTypeTreasuryCreditReversalPosted,
+	stripe.EventTypeTreasuryDebitReversalCompleted,
+	stripe.EventTypeTreasuryDebitReversalCreated,
+	stripe.EventTypeTreasuryDebitReversalInitialCreditGranted,
+	stripe.EventTypeTreasuryFinancialAccountClosed,
+	stripe.EventTypeTreasuryFinancialAccountCreated,
+	stripe.EventTypeTreasuryFinancialAccountFeaturesStatusUpdated,
+	stripe.EventTypeTreasuryInboundTransferCanceled,
+	stripe.EventTypeTreasuryInboundTransferCreated,
+	stripe.EventTypeTreasuryInboundTransferFailed,
+	stripe.EventTypeTreasuryInboundTransferSucceeded,
+	stripe.EventTypeTreasuryOutboundPaymentCanceled,
+	stripe.EventTypeTreasuryOutboundPaymentCreated,
+	stripe.EventTypeTreasuryOutboundPaymentExpectedArrivalDateUpdated,
+	stripe.EventTypeTreasuryOutboundPaymentFailed,
+	stripe.EventTypeTreasuryOutboundPaymentPosted,
+	stripe.EventTypeTreasuryOutboundPaymentReturned,
+	stripe.EventTypeTreasuryOutboundPaymentTrackingDetailsUpdated,
+	stripe.EventTypeTreasuryOutboundTransferCanceled,
+	stripe.EventTypeTreasuryOutboundTransferCreated,
+	stripe.EventTypeTreasuryOutboundTransferExpectedArrivalDateUpdated,
+	stripe.EventTypeTreasuryOutboundTransferFailed,
+	stripe.EventTypeTreasuryOutboundTransferPosted,
+	stripe.EventTypeTreasuryOutboundTransferReturned,
+	stripe.EventTypeTreasuryOutboundTransferTrackingDetailsUpdated,
+	stripe.EventTypeTreasuryReceivedCreditCreated,
+	stripe.EventTypeTreasuryReceivedCreditFailed,
+	stripe.EventTypeTreasuryReceivedCreditSucceeded,
+	stripe.EventTypeTreasuryReceivedDebitCreated,
+}
+
+// isValidEventType reports whether name can be given in the enabled_events of
+// a webhook endpoint, being a known event type or the `*` wildcard.
+func isValidEventType(name string) bool {
+	return name == "*" || slices.Contains(webhookEventTypes, stripe.EventType(name))
+}
+
+func NewIsValidEventFunction() function.Function {
+	return &IsValidEventFunction{}
+}
+
+// IsValidEventFunction defines the function implementation.
+type IsValidEventFunction struct{}
+
+func (f *IsValidEventFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_event"
+}
+
+func (f *IsValidEventFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check a webhook event name",
+		MarkdownDescription: "Returns whether a name can be used in the `enabled_events` of a webhook endpoint, being either a known event type such as `invoice.paid` or the `*` wildcard. Event types are known as of the provider's release, so newer event types are reported as invalid.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The event name to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsValidEventFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, isValidEventType(name)))
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidEventFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventName string
+		want      types.Bool
+	}{
+		{"known event", "invoice.paid", types.BoolValue(true)},
+		{"nested event", "customer.subscription.updated", types.BoolValue(true)},
+		{"wildcard", "*", types.BoolValue(true)},
+		{"prefix wildcard", "invoice.*", types.BoolValue(false)},
+		{"unknown event", "invoice.paided", types.BoolValue(false)},
+		{"wrong case", "Invoice.Paid", types.BoolValue(false)},
+		{"empty", "", types.BoolValue(false)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &IsValidEventFunction{}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.eventName)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.BoolUnknown()),
+			}
+			f.Run(context.Background(), req, resp)
+
+			assert.Nil(t, resp.Error)
+			assert.Equal(t, tt.want, resp.Result.Value())
+		})
+	}
+}
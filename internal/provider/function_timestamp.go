@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &TimestampFunction{}
+
+func NewTimestampFunction() function.Function {
+	return &TimestampFunction{}
+}
+
+// TimestampFunction defines the function implementation.
+type TimestampFunction struct{}
+
+func (f *TimestampFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "timestamp"
+}
+
+func (f *TimestampFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Convert an RFC 3339 timestamp to a Unix timestamp",
+		MarkdownDescription: "Returns the number of seconds since the Unix epoch of an RFC 3339 timestamp, as Stripe expects for timestamp arguments such as `redeem_by`. The UTC offset of the timestamp is taken into account, so `2025-12-31T01:00:00+01:00` and `2025-12-31T00:00:00Z` give the same result.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "timestamp",
+				MarkdownDescription: "RFC 3339 timestamp with a UTC offset, such as `2025-12-31T00:00:00Z`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *TimestampFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an RFC 3339 timestamp", timestamp))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, t.Unix()))
+}
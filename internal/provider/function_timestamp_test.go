@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimestampFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp string
+		want      types.Int64
+		expectErr bool
+	}{
+		{"utc", "2025-12-31T00:00:00Z", types.Int64Value(1767139200), false},
+		{"positive offset", "2025-12-31T01:00:00+01:00", types.Int64Value(1767139200), false},
+		{"negative offset", "2025-12-30T19:00:00-05:00", types.Int64Value(1767139200), false},
+		{"fractional seconds", "2025-12-31T00:00:00.999Z", types.Int64Value(1767139200), false},
+		{"epoch", "1970-01-01T00:00:00Z", types.Int64Value(0), false},
+		{"date only", "2025-12-31", types.Int64Unknown(), true},
+		{"no offset", "2025-12-31T00:00:00", types.Int64Unknown(), true},
+		{"unix timestamp", "1767139200", types.Int64Unknown(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &TimestampFunction{}
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.timestamp)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.Int64Unknown()),
+			}
+			f.Run(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectErr, resp.Error != nil)
+			assert.Equal(t, tt.want, resp.Result.Value())
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// moveStateSourceProviders lists the providers whose resources can be moved
+// into this provider with a `moved` block. Their product, price and coupon
+// resources share the Stripe API attribute names used here.
+var moveStateSourceProviders = []string{
+	"registry.terraform.io/franckverrot/stripe",
+	"registry.terraform.io/lukasaron/stripe",
+}
+
+// moveStateMovers returns the state movers for a resource that accepts moves
+// from the resource of the same type name in one of moveStateSourceProviders.
+// The prior state is decoded from its raw JSON, since the source schemas
+// differ between providers and versions, and handed to move to populate the
+// target state. Anything not carried over is refreshed by the following read.
+func moveStateMovers(typeName string, move func(ctx context.Context, attrs map[string]any, resp *resource.MoveStateResponse)) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != typeName || !slices.Contains(moveStateSourceProviders, req.SourceProviderAddress) {
+					return
+				}
+				if req.SourceRawState == nil {
+					return
+				}
+
+				attrs := map[string]any{}
+				decoder := json.NewDecoder(bytes.NewReader(req.SourceRawState.JSON))
+				decoder.UseNumber()
+				if err := decoder.Decode(&attrs); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("The %s state from %s could not be decoded: %s", req.SourceTypeName, req.SourceProviderAddress, err),
+					)
+					return
+				}
+				if movedString(attrs, "id").IsNull() {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("The %s state from %s has no id.", req.SourceTypeName, req.SourceProviderAddress),
+					)
+					return
+				}
+
+				move(ctx, attrs, resp)
+			},
+		},
+	}
+}
+
+// movedString returns a string attribute of a moved state. Numbers are
+// formatted, and empty strings, which SDKv2 providers store for unset
+// attributes, are null.
+func movedString(attrs map[string]any, key string) types.String {
+	switch v := attrs[key].(type) {
+	case string:
+		return StringNullIfEmpty(v)
+	case json.Number:
+		return types.StringValue(v.String())
+	}
+	return types.StringNull()
+}
+
+// movedBool returns a bool attribute of a moved state.
+func movedBool(attrs map[string]any, key string) types.Bool {
+	if v, ok := attrs[key].(bool); ok {
+		return types.BoolValue(v)
+	}
+	return types.BoolNull()
+}
+
+// movedInt64 returns an integer attribute of a moved state, accepting numbers
+// and numeric strings. Zero is null.
+func movedInt64(attrs map[string]any, key string) types.Int64 {
+	switch v := attrs[key].(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return Int64NullIfEmpty(i)
+		}
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return Int64NullIfEmpty(i)
+		}
+	}
+	return types.Int64Null()
+}
+
+// movedFloat64 returns a float attribute of a moved state, accepting numbers
+// and numeric strings. Zero is null.
+func movedFloat64(attrs map[string]any, key string) types.Float64 {
+	switch v := attrs[key].(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return Float64NullIfEmpty(f)
+		}
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return Float64NullIfEmpty(f)
+		}
+	}
+	return types.Float64Null()
+}
+
+// movedTimestamp returns a timestamp attribute of a moved state as seconds
+// since the Unix epoch, accepting both epoch seconds and RFC 3339 strings.
+func movedTimestamp(attrs map[string]any, key string) types.Int64 {
+	if v, ok := attrs[key].(string); ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return types.Int64Value(t.Unix())
+		}
+	}
+	return movedInt64(attrs, key)
+}
+
+// movedStrings returns the non-empty strings of a list or set attribute of a
+// moved state.
+func movedStrings(attrs map[string]any, key string) []string {
+	list, _ := attrs[key].([]any)
+	var values []string
+	for _, element := range list {
+		if s, ok := element.(string); ok && s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// movedStringMap returns a map attribute of a moved state.
+func movedStringMap(attrs map[string]any, key string) map[string]string {
+	m, _ := attrs[key].(map[string]any)
+	values := map[string]string{}
+	for k := range m {
+		values[k] = movedString(m, k).ValueString()
+	}
+	return values
+}
+
+// movedObject returns a nested attribute of a moved state, or nil when it is
+// unset. SDKv2 providers store single nested blocks as a list with one
+// element, which is unwrapped.
+func movedObject(attrs map[string]any, key string) map[string]any {
+	switch v := attrs[key].(type) {
+	case map[string]any:
+		if len(v) > 0 {
+			return v
+		}
+	case []any:
+		if len(v) > 0 {
+			if m, ok := v[0].(map[string]any); ok {
+				return m
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMoveState runs the state movers of r against a prior state with the
+// given provider address, type name and raw JSON, returning the response of
+// the first mover that handled it, or of the last mover otherwise.
+func testMoveState(t *testing.T, r resource.ResourceWithMoveState, sourceProvider, sourceType, rawState string) *resource.MoveStateResponse {
+	ctx := context.Background()
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: sourceProvider,
+		SourceTypeName:        sourceType,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+
+	var resp *resource.MoveStateResponse
+	for _, mover := range r.MoveState(ctx) {
+		resp = &resource.MoveStateResponse{TargetState: testState(t, r, nil)}
+		mover.StateMover(ctx, req, resp)
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			break
+		}
+	}
+	return resp
+}
+
+func TestProductResourceMoveState(t *testing.T) {
+	resp := testMoveState(t, &ProductResource{}, "registry.terraform.io/lukasaron/stripe", "stripe_product", `{
+		"id": "prod_123",
+		"active": true,
+		"description": "",
+		"images": ["https://example.com/image.png"],
+		"metadata": {"plan": "standard"},
+		"name": "Standard",
+		"package_dimensions": [{"height": 1, "length": 2, "weight": 3.5, "width": 4}],
+		"shippable": false,
+		"statement_descriptor": "",
+		"unit_label": "seat",
+		"url": ""
+	}`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state ProductResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, ProductResourceModel{
+		Id:                types.StringValue("prod_123"),
+		Active:            types.BoolValue(true),
+		DefaultPrice:      types.StringNull(),
+		DefaultPriceData:  types.ObjectNull(ProductDefaultPriceDataModel{}.Types()),
+		Description:       types.StringNull(),
+		Images:            testListValue(t, types.StringType, []string{"https://example.com/image.png"}),
+		MarketingFeatures: types.ListNull(types.StringType),
+		Metadata:          testMapValue(t, types.StringType, map[string]interface{}{"plan": "standard"}),
+		MetadataKeys:      testListValue(t, types.StringType, []string{"plan"}),
+		Name:              types.StringValue("Standard"),
+		PackageDimensions: types.ObjectValueMust(ProductPackageDimensionsResourceModel{}.Types(), map[string]attr.Value{
+			"height": types.Float64Value(1),
+			"length": types.Float64Value(2),
+			"weight": types.Float64Value(3.5),
+			"width":  types.Float64Value(4),
+		}),
+		Shippable:           types.BoolValue(false),
+		StatementDescriptor: types.StringNull(),
+		TaxCode:             types.StringNull(),
+		UnitLabel:           types.StringValue("seat"),
+		URL:                 types.StringNull(),
+	}, state)
+}
+
+func TestPriceResourceMoveState(t *testing.T) {
+	resp := testMoveState(t, &PriceResource{}, "registry.terraform.io/franckverrot/stripe", "stripe_price", `{
+		"id": "price_123",
+		"active": true,
+		"billing_scheme": "per_unit",
+		"currency": "usd",
+		"metadata": {},
+		"nickname": "Monthly",
+		"product": "prod_123",
+		"recurring": {"interval": "month", "interval_count": "1"},
+		"unit_amount": 1500
+	}`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state PriceResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, types.StringValue("price_123"), state.Id)
+	assert.Equal(t, types.StringValue("usd"), state.Currency)
+	assert.Equal(t, types.MapNull(types.StringType), state.Metadata)
+	assert.Equal(t, types.StringValue("Monthly"), state.Nickname)
+	assert.Equal(t, types.StringValue("prod_123"), state.Product)
+	assert.Equal(t, types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+		"interval":        types.StringValue("month"),
+		"aggregate_usage": types.StringValue("sum"),
+		"interval_count":  types.Int64Value(1),
+		"meter":           types.StringNull(),
+		"usage_type":      types.StringValue("licensed"),
+	}), state.Recurring)
+	assert.Equal(t, types.ObjectNull(PriceTransformQuantity{}.Types()), state.TransformQuantity)
+	assert.Equal(t, types.Int64Value(1500), state.UnitAmount)
+	assert.Equal(t, types.Float64Null(), state.UnitAmountDecimal)
+}
+
+func TestCouponResourceMoveState(t *testing.T) {
+	resp := testMoveState(t, &CouponResource{}, "registry.terraform.io/lukasaron/stripe", "stripe_coupon", `{
+		"id": "SUMMER",
+		"amount_off": 500,
+		"currency": "usd",
+		"duration": "repeating",
+		"duration_in_months": 3,
+		"max_redemptions": 0,
+		"name": "Summer sale",
+		"percent_off": 0,
+		"redeem_by": "2030-01-01T00:00:00Z",
+		"valid": true
+	}`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state CouponResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, CouponResourceModel{
+		Id:        types.StringValue("SUMMER"),
+		AppliesTo: types.ListNull(types.StringType),
+		CurrencyOptions: types.MapValueMust(types.ObjectType{AttrTypes: CouponCurrencyOptionsModel{}.Types()}, map[string]attr.Value{
+			"usd": types.ObjectValueMust(CouponCurrencyOptionsModel{}.Types(), map[string]attr.Value{
+				"amount_off": types.Int64Value(500),
+				"top_level":  types.BoolValue(true),
+			}),
+		}),
+		Duration:         types.StringValue("repeating"),
+		DurationInMonths: types.Int64Value(3),
+		MaxRedemptions:   types.Int64Null(),
+		Metadata:         types.MapNull(types.StringType),
+		MetadataKeys:     testListValue(t, types.StringType, []string{}),
+		Name:             types.StringValue("Summer sale"),
+		PercentOff:       types.Float64Null(),
+		RedeemBy:         types.Int64Value(1893456000),
+		Valid:            types.BoolValue(true),
+	}, state)
+}
+
+func TestMoveStateUnsupportedSource(t *testing.T) {
+	tests := []struct {
+		name           string
+		sourceProvider string
+		sourceType     string
+	}{
+		{"unknown provider", "registry.terraform.io/example/stripe", "stripe_product"},
+		{"other resource type", "registry.terraform.io/lukasaron/stripe", "stripe_price"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := testMoveState(t, &ProductResource{}, tt.sourceProvider, tt.sourceType, `{"id": "prod_123"}`)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.TargetState.Raw.IsNull())
+		})
+	}
+}
+
+func TestMoveStateMissingID(t *testing.T) {
+	resp := testMoveState(t, &ProductResource{}, "registry.terraform.io/lukasaron/stripe", "stripe_product", `{"name": "Standard"}`)
+	assert.True(t, resp.Diagnostics.HasError())
+}
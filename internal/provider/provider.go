@@ -2,15 +2,27 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stripe/stripe-go/v81"
 	"github.com/stripe/stripe-go/v81/client"
 )
 
@@ -28,7 +40,115 @@ type StripeProvider struct {
 
 // StripeProviderModel describes the provider data model.
 type StripeProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey                 types.String `tfsdk:"api_key"`
+	APIKeyFile             types.String `tfsdk:"api_key_file"`
+	Debug                  types.Bool   `tfsdk:"debug"`
+	DefaultTaxBehavior     types.String `tfsdk:"default_tax_behavior"`
+	IdleConnTimeoutSeconds types.Int64  `tfsdk:"idle_conn_timeout_seconds"`
+	MaxIdleConns           types.Int64  `tfsdk:"max_idle_conns"`
+	ProxyURL               types.String `tfsdk:"proxy_url"`
+	UserAgentSuffix        types.String `tfsdk:"user_agent_suffix"`
+	ValidateAgainstAPI     types.Bool   `tfsdk:"validate_against_api"`
+	WarnMissingTaxCode     types.Bool   `tfsdk:"warn_missing_tax_code"`
+}
+
+// StripeProviderData is passed to data sources and resources when they are configured.
+type StripeProviderData struct {
+	// APIVersion is the Stripe API version that requests are made with.
+	APIVersion         string
+	Client             *client.API
+	DefaultTaxBehavior string
+	LiveAPIKey         bool
+	ValidateAgainstAPI bool
+	WarnMissingTaxCode bool
+
+	taxSettingsOnce   sync.Once
+	taxSettingsActive bool
+	taxSettingsErr    error
+
+	livemodeOnce sync.Once
+	livemode     bool
+	livemodeErr  error
+
+	currenciesOnce sync.Once
+	currencies     []stripe.Currency
+	currenciesErr  error
+}
+
+// TaxSettingsActive reports whether Stripe Tax is active on the account. The
+// tax settings are only fetched once per provider instance.
+func (d *StripeProviderData) TaxSettingsActive() (bool, error) {
+	d.taxSettingsOnce.Do(func() {
+		var settings *stripe.TaxSettings
+		settings, d.taxSettingsErr = d.Client.TaxSettings.Get(nil)
+		if d.taxSettingsErr == nil {
+			d.taxSettingsActive = settings.Status == stripe.TaxSettingsStatusActive
+		}
+	})
+	return d.taxSettingsActive, d.taxSettingsErr
+}
+
+// Livemode reports whether the API key operates in live mode. The mode is
+// taken from the account balance, which is only fetched once per provider
+// instance.
+func (d *StripeProviderData) Livemode() (bool, error) {
+	d.livemodeOnce.Do(func() {
+		var balance *stripe.Balance
+		balance, d.livemodeErr = d.Client.Balance.Get(nil)
+		if d.livemodeErr == nil {
+			d.livemode = balance.Livemode
+		}
+	})
+	return d.livemode, d.livemodeErr
+}
+
+// SupportedCurrencies returns the payment currencies supported in the country
+// of the account. The account and its country spec are only fetched once per
+// provider instance.
+func (d *StripeProviderData) SupportedCurrencies() ([]stripe.Currency, error) {
+	d.currenciesOnce.Do(func() {
+		var account *stripe.Account
+		account, d.currenciesErr = d.Client.Accounts.Get()
+		if d.currenciesErr != nil {
+			return
+		}
+		var spec *stripe.CountrySpec
+		spec, d.currenciesErr = d.Client.CountrySpecs.Get(account.Country, nil)
+		if d.currenciesErr == nil {
+			d.currencies = spec.SupportedPaymentCurrencies
+		}
+	})
+	return d.currencies, d.currenciesErr
+}
+
+// CheckRequireLivemode returns an error diagnostic when requireLivemode is set
+// and does not match the mode of the API key.
+func (d *StripeProviderData) CheckRequireLivemode(requireLivemode types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if requireLivemode.IsNull() || requireLivemode.IsUnknown() {
+		return diags
+	}
+
+	livemode, err := d.Livemode()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to determine the API key mode, got error: %s", formatStripeError(err)))
+		return diags
+	}
+	if livemode != requireLivemode.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("require_livemode"),
+			"API key mode mismatch",
+			fmt.Sprintf("The resource requires a %s mode API key, but the provider is configured with a %s mode key. Nothing was created.", modeName(requireLivemode.ValueBool()), modeName(livemode)),
+		)
+	}
+	return diags
+}
+
+func modeName(livemode bool) string {
+	if livemode {
+		return "live"
+	}
+	return "test"
 }
 
 func (p *StripeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,6 +164,51 @@ func (p *StripeProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the Stripe API key, such as a secret mounted by Kubernetes or Vault. Surrounding whitespace is trimmed. Takes precedence over the `STRIPE_API_KEY` environment variable, but not over `api_key`.",
+				Optional:            true,
+			},
+			"debug": schema.BoolAttribute{
+				MarkdownDescription: "Log the method, path and response status of every request to the Stripe API at the `DEBUG` level, such as when `TF_LOG=DEBUG` is set. Request and response bodies are never logged. Defaults to `false`.",
+				Optional:            true,
+			},
+			"default_tax_behavior": schema.StringAttribute{
+				MarkdownDescription: "The `tax_behavior` given to new prices, and new currency options of prices, that do not set one. Either `exclusive`, `inclusive` or `unspecified`. Defaults to `unspecified`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("exclusive", "inclusive", "unspecified"),
+				},
+			},
+			"idle_conn_timeout_seconds": schema.Int64Attribute{
+				MarkdownDescription: "How long, in seconds, an idle connection to the Stripe API is kept open before it is closed. Defaults to 90.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of idle connections kept open to the Stripe API. Raise this for applies with high parallelism. Defaults to 2.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy that requests to the Stripe API are sent through, such as `http://proxy.example.com:3128`. The `http`, `https` and `socks5` schemes are supported. Defaults to the proxy given by the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "A suffix appended to the `User-Agent` header of requests to the Stripe API, such as `my-tool/1.0`. Helps attribute traffic when several tools share a Stripe account.",
+				Optional:            true,
+			},
+			"validate_against_api": schema.BoolAttribute{
+				MarkdownDescription: "Validate resources against the Stripe API at plan time, such as whether a product `tax_code` exists, a price currency is supported by the account or the `applies_to` products of a coupon exist. Makes additional read-only API calls during plan. Defaults to `false`.",
+				Optional:            true,
+			},
+			"warn_missing_tax_code": schema.BoolAttribute{
+				MarkdownDescription: "Warn at plan time when a product has no `tax_code` while Stripe Tax is active on the account. Enabling this makes one additional API call per run.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -63,16 +228,35 @@ func (p *StripeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.APIKeyFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key_file"),
+			"Unknown Stripe API key file",
+			"The Stripe API key file must be known when the provider is configured.",
+		)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Unknown proxy URL",
+			"The proxy URL must be known when the provider is configured.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiKey := os.Getenv("STRIPE_API_KEY")
-
-	if !config.APIKey.IsNull() {
-		apiKey = config.APIKey.ValueString()
+	apiKey, diags := resolveAPIKey(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	proxyURL, diags := parseProxyURL(config)
+	resp.Diagnostics.Append(diags...)
+
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -85,15 +269,110 @@ func (p *StripeProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Example client configuration for data sources and resources
-	stripeAPI := client.New(apiKey, nil)
-	resp.DataSourceData = stripeAPI
-	resp.ResourceData = stripeAPI
+	providerData := &StripeProviderData{
+		APIVersion:         stripe.APIVersion,
+		Client:             client.New(apiKey, stripe.NewBackends(newHTTPClient(ctx, config, proxyURL))),
+		DefaultTaxBehavior: config.DefaultTaxBehavior.ValueString(),
+		LiveAPIKey:         isLiveAPIKey(apiKey),
+		ValidateAgainstAPI: config.ValidateAgainstAPI.ValueBool(),
+		WarnMissingTaxCode: config.WarnMissingTaxCode.ValueBool(),
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+// resolveAPIKey returns the API key of the provider configuration, taken from
+// api_key, the file at api_key_file or the STRIPE_API_KEY environment
+// variable, in that order of precedence.
+func resolveAPIKey(config StripeProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !config.APIKey.IsNull() {
+		return config.APIKey.ValueString(), diags
+	}
+
+	if !config.APIKeyFile.IsNull() {
+		content, err := os.ReadFile(config.APIKeyFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("api_key_file"),
+				"Unable to read Stripe API key file",
+				fmt.Sprintf("The Stripe API key file could not be read: %s", err),
+			)
+			return "", diags
+		}
+		return strings.TrimSpace(string(content)), diags
+	}
+
+	return os.Getenv("STRIPE_API_KEY"), diags
+}
+
+// parseProxyURL returns the proxy_url of the provider configuration, or nil
+// when it is not set.
+func parseProxyURL(config StripeProviderModel) (*url.URL, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if config.ProxyURL.IsNull() {
+		return nil, diags
+	}
+
+	proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+	if err != nil || proxyURL.Host == "" || !slices.Contains([]string{"http", "https", "socks5"}, proxyURL.Scheme) {
+		diags.AddAttributeError(
+			path.Root("proxy_url"),
+			"Invalid proxy URL",
+			fmt.Sprintf("The proxy URL %q must be an absolute http, https or socks5 URL.", config.ProxyURL.ValueString()),
+		)
+		return nil, diags
+	}
+	return proxyURL, diags
+}
+
+// isLiveAPIKey reports whether apiKey is a live mode secret or restricted key,
+// going by its prefix.
+func isLiveAPIKey(apiKey string) bool {
+	return strings.HasPrefix(apiKey, "sk_live_") || strings.HasPrefix(apiKey, "rk_live_")
+}
+
+// newHTTPClient returns the HTTP client used for requests to the Stripe API,
+// with its transport tuned by the provider configuration. Requests go through
+// proxyURL when set, and otherwise through the proxy of the environment. When
+// debug is set, requests are logged to the logger of ctx.
+func newHTTPClient(ctx context.Context, config StripeProviderModel, proxyURL *url.URL) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if !config.MaxIdleConns.IsNull() {
+		transport.MaxIdleConns = int(config.MaxIdleConns.ValueInt64())
+		transport.MaxIdleConnsPerHost = int(config.MaxIdleConns.ValueInt64())
+	}
+	if !config.IdleConnTimeoutSeconds.IsNull() {
+		transport.IdleConnTimeout = time.Duration(config.IdleConnTimeoutSeconds.ValueInt64()) * time.Second
+	}
+
+	var roundTripper http.RoundTripper = &unavailableRetryTransport{
+		base:       transport,
+		maxRetries: unavailableMaxRetries,
+		backoff:    unavailableBackoff,
+	}
+	if suffix := config.UserAgentSuffix.ValueString(); suffix != "" {
+		roundTripper = &userAgentTransport{base: roundTripper, suffix: suffix}
+	}
+	if config.Debug.ValueBool() {
+		roundTripper = &requestLogTransport{base: roundTripper, ctx: ctx}
+	}
+
+	return &http.Client{
+		// Matches the timeout of the Stripe library's default client.
+		Timeout:   80 * time.Second,
+		Transport: roundTripper,
+	}
 }
 
 func (p *StripeProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCouponResource,
+		NewCustomerResource,
+		NewCustomerDiscountResource,
 		NewPriceResource,
 		NewProductResource,
 		NewWebhookEndpointResource,
@@ -101,11 +380,26 @@ func (p *StripeProvider) Resources(ctx context.Context) []func() resource.Resour
 }
 
 func (p *StripeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewBalanceDataSource,
+		NewCouponsDataSource,
+		NewCreditNotePreviewDataSource,
+		NewCustomerSubscriptionsDataSource,
+		NewInvoicesDataSource,
+		NewPricesDataSource,
+		NewProductDefaultPriceDataSource,
+		NewProductsDataSource,
+		NewTaxRatesDataSource,
+	}
 }
 
 func (p *StripeProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCurrencyUpperFunction,
+		NewGeneratePromoCodeFunction,
+		NewIsValidEventFunction,
+		NewTimestampFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,7 +35,10 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CouponResource{}
+var _ resource.ResourceWithConfigValidators = &CouponResource{}
 var _ resource.ResourceWithImportState = &CouponResource{}
+var _ resource.ResourceWithModifyPlan = &CouponResource{}
+var _ resource.ResourceWithMoveState = &CouponResource{}
 
 func NewCouponResource() resource.Resource {
 	return &CouponResource{}
@@ -38,21 +46,27 @@ func NewCouponResource() resource.Resource {
 
 // CouponResource defines the resource implementation.
 type CouponResource struct {
-	sc *client.API
+	sc           *client.API
+	providerData *StripeProviderData
 }
 
 // CouponResourceModel describes the resource data model.
 type CouponResourceModel struct {
-	Id               types.String  `tfsdk:"id"`
-	AppliesTo        types.List    `tfsdk:"applies_to"`
-	CurrencyOptions  types.Map     `tfsdk:"currency_options"`
-	Duration         types.String  `tfsdk:"duration"`
-	DurationInMonths types.Int64   `tfsdk:"duration_in_months"`
-	MaxRedemptions   types.Int64   `tfsdk:"max_redemptions"`
-	Metadata         types.Map     `tfsdk:"metadata"`
-	Name             types.String  `tfsdk:"name"`
-	PercentOff       types.Float64 `tfsdk:"percent_off"`
-	RedeemBy         types.Int64   `tfsdk:"redeem_by"`
+	Id                               types.String  `tfsdk:"id"`
+	AppliesTo                        types.List    `tfsdk:"applies_to"`
+	ConfigHash                       types.String  `tfsdk:"config_hash"`
+	CurrencyOptions                  types.Map     `tfsdk:"currency_options"`
+	DeactivatePromotionCodesOnDelete types.Bool    `tfsdk:"deactivate_promotion_codes_on_delete"`
+	Duration                         types.String  `tfsdk:"duration"`
+	DurationInMonths                 types.Int64   `tfsdk:"duration_in_months"`
+	MaxRedemptions                   types.Int64   `tfsdk:"max_redemptions"`
+	Metadata                         types.Map     `tfsdk:"metadata"`
+	MetadataKeys                     types.List    `tfsdk:"metadata_keys"`
+	Name                             types.String  `tfsdk:"name"`
+	PercentOff                       types.Float64 `tfsdk:"percent_off"`
+	RedeemBy                         types.Int64   `tfsdk:"redeem_by"`
+	RequireLivemode                  types.Bool    `tfsdk:"require_livemode"`
+	Valid                            types.Bool    `tfsdk:"valid"`
 }
 
 type CouponCurrencyOptionsModel struct {
@@ -74,7 +88,7 @@ func (r *CouponResource) Metadata(ctx context.Context, req resource.MetadataRequ
 func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "A webhook endpoint resource",
+		MarkdownDescription: "A coupon contains information about a percent-off or amount-off discount you might want to apply to a customer.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -97,6 +111,7 @@ func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listvalidator.UniqueValues(),
 				},
 			},
+			"config_hash": configHashAttribute(),
 			"currency_options": schema.MapNestedAttribute{
 				MarkdownDescription: "Coupons defined in each available currency option. Each key must be a three-letter ISO currency code and a supported currency.",
 				NestedObject: schema.NestedAttributeObject{
@@ -148,9 +163,14 @@ func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					),
 				},
 				Validators: []validator.Map{
-					mapvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("percent_off")),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(lowercaseCurrencyRegexp, "must be a lowercase three-letter ISO currency code")),
 				},
 			},
+			"deactivate_promotion_codes_on_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether to deactivate the active promotion codes of the coupon before deleting it. Stripe keeps promotion codes of deleted coupons, which can no longer be redeemed but are still listed as active.",
+				Optional:            true,
+			},
 			"duration": schema.StringAttribute{
 				MarkdownDescription: "One of `forever`, `once`, and `repeating`. Describes how long a customer who applies this coupon will get the discount.",
 				Optional:            true,
@@ -165,28 +185,20 @@ func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
-					int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("duration")),
 				},
 			},
 			"max_redemptions": schema.Int64Attribute{
 				MarkdownDescription: "Maximum number of times this coupon can be redeemed, in total, across all customers, before it is no longer valid.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
 				},
 			},
-			"metadata": schema.MapAttribute{
-				MarkdownDescription: "Set of key-value pairs that you can attach to an object. ",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Validators: []validator.Map{
-					mapvalidator.SizeAtMost(50),
-					mapvalidator.KeysAre(
-						stringvalidator.LengthAtMost(40)),
-					mapvalidator.ValueStringsAre(
-						stringvalidator.LengthAtMost(500)),
-				},
-			},
+			"metadata":      metadataAttribute(),
+			"metadata_keys": metadataKeysAttribute(),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Name of the coupon displayed to customers on for instance invoices or receipts.",
 				Optional:            true,
@@ -199,35 +211,183 @@ func (r *CouponResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 				Validators: []validator.Float64{
 					float64validator.Between(1, 100),
-					float64validator.ConflictsWith(path.MatchRelative().AtParent().AtName("currency_options")),
 				},
 			},
 			"redeem_by": schema.Int64Attribute{
-				MarkdownDescription: "Date after which the coupon can no longer be redeemed.",
+				MarkdownDescription: "Date after which the coupon can no longer be redeemed. Measured in seconds since the Unix epoch; use `provider::stripe::timestamp` to set it from an RFC 3339 timestamp.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"require_livemode": requireLivemodeAttribute(),
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Taking account of the above properties, whether this coupon can still be applied to a customer.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r *CouponResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("currency_options"),
+			path.MatchRoot("percent_off"),
+		),
+		couponTopLevelValidator{},
+		couponDurationValidator{},
+	}
+}
+
+// couponTopLevelValidator requires exactly one currency option of a coupon to
+// be the top-level one, which gives the currency and amount_off of the coupon
+// itself.
+type couponTopLevelValidator struct{}
+
+func (v couponTopLevelValidator) Description(_ context.Context) string {
+	return "exactly one entry of currency_options must set top_level"
+}
+
+func (v couponTopLevelValidator) MarkdownDescription(_ context.Context) string {
+	return "exactly one entry of `currency_options` must set `top_level`"
+}
+
+func (v couponTopLevelValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var currencyOptions types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currency_options"), &currencyOptions)...)
+	if resp.Diagnostics.HasError() || currencyOptions.IsNull() || currencyOptions.IsUnknown() {
+		return
+	}
+
+	var topLevel []string
+	for currency, element := range currencyOptions.Elements() {
+		option, ok := element.(types.Object)
+		if !ok || option.IsUnknown() {
+			return
+		}
+		value, ok := option.Attributes()["top_level"].(types.Bool)
+		if !ok || value.IsUnknown() {
+			return
+		}
+		if value.ValueBool() {
+			topLevel = append(topLevel, currency)
+		}
+	}
+	if len(topLevel) == 1 {
+		return
+	}
+
+	slices.Sort(topLevel)
+	detail := "No entry of currency_options sets top_level."
+	if len(topLevel) > 1 {
+		detail = fmt.Sprintf("The entries %s of currency_options all set top_level.", strings.Join(topLevel, ", "))
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("currency_options"),
+		"Invalid Attribute Combination",
+		"Exactly one entry of currency_options must set top_level, which gives the currency and amount_off of the coupon itself. "+detail,
+	)
+}
+
+// couponDurationValidator requires duration_in_months to be set if, and only
+// if, the duration of a coupon is repeating.
+type couponDurationValidator struct{}
+
+func (v couponDurationValidator) Description(_ context.Context) string {
+	return "duration_in_months must be set if and only if duration is repeating"
+}
+
+func (v couponDurationValidator) MarkdownDescription(_ context.Context) string {
+	return "`duration_in_months` must be set if and only if `duration` is `repeating`"
+}
+
+func (v couponDurationValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var duration types.String
+	var durationInMonths types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration"), &duration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("duration_in_months"), &durationInMonths)...)
+	if resp.Diagnostics.HasError() || duration.IsUnknown() || durationInMonths.IsUnknown() {
+		return
+	}
+
+	// A null duration takes the default of once.
+	repeating := duration.ValueString() == "repeating"
+	if repeating && durationInMonths.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration_in_months"),
+			"Missing Attribute Configuration",
+			"duration_in_months must be set when duration is repeating.",
+		)
+	}
+	if !repeating && !durationInMonths.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration_in_months"),
+			"Invalid Attribute Combination",
+			"duration_in_months can only be set when duration is repeating.",
+		)
+	}
+}
+
 func (r *CouponResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	sc, ok := req.ProviderData.(*client.API)
+	providerData, ok := req.ProviderData.(*StripeProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sc = sc
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *CouponResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying, or without a configured provider,
+	// such as when credentials are not known yet.
+	if req.Plan.Raw.IsNull() || r.providerData == nil || !r.providerData.ValidateAgainstAPI {
+		return
+	}
+
+	var planAppliesTo, stateAppliesTo types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("applies_to"), &planAppliesTo)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("applies_to"), &stateAppliesTo)...)
+	}
+	if resp.Diagnostics.HasError() || planAppliesTo.IsNull() || planAppliesTo.IsUnknown() || planAppliesTo.Equal(stateAppliesTo) {
+		return
+	}
+
+	var productIDs []string
+	for _, element := range planAppliesTo.Elements() {
+		if productID, ok := element.(types.String); ok && !productID.IsNull() && !productID.IsUnknown() {
+			productIDs = append(productIDs, productID.ValueString())
+		}
+	}
+
+	missing, err := r.findMissingProducts(productIDs)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to validate applies_to products, got error: %s", formatStripeError(err)))
+		return
+	}
+	for _, productID := range missing {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("applies_to"),
+			"Unknown product",
+			fmt.Sprintf("The product %s does not exist in Stripe.", productID),
+		)
+	}
 }
 
 func (r *CouponResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -242,16 +402,29 @@ func (r *CouponResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := r.buildCreateParams(ctx, plan, resp.Diagnostics)
 	params.AddExpand("currency_options")
 	coupon, err = r.sc.Coupons.New(params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create coupon, got error: %s", formatStripeError(err)))
 		return
 	}
 
 	plan.Id = types.StringValue(coupon.ID)
-	r.populateModel(ctx, &plan, coupon, resp.Diagnostics)
+	var populateDiags diag.Diagnostics
+	r.populateModel(ctx, &plan, coupon, &populateDiags)
+	resp.Diagnostics.Append(populateDiags...)
+	if populateDiags.HasError() {
+		// Keep track of the created coupon so that it is not leaked. The
+		// error taints it, so it is replaced on the next apply.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+		return
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -276,12 +449,24 @@ func (r *CouponResource) Read(ctx context.Context, req resource.ReadRequest, res
 	params := &stripe.CouponParams{}
 	params.AddExpand("currency_options")
 	coupon, err = r.sc.Coupons.Get(state.Id.ValueString(), params)
+	if isNotFound(err) {
+		tflog.Warn(ctx, "Coupon not found, removing from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read coupon, got error: %s", formatStripeError(err)))
 		return
 	}
 
-	r.populateModel(ctx, &state, coupon, resp.Diagnostics)
+	resp.Diagnostics.Append(checkStripeObject(coupon.Object, "coupon", coupon.ID, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.populateModel(ctx, &state, coupon, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -308,10 +493,10 @@ func (r *CouponResource) Update(ctx context.Context, req resource.UpdateRequest,
 	params.AddExpand("currency_options")
 	coupon, err = r.sc.Coupons.Update(plan.Id.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update coupon, got error: %s", formatStripeError(err)))
 		return
 	}
-	r.populateModel(ctx, &plan, coupon, resp.Diagnostics)
+	r.populateModel(ctx, &plan, coupon, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -328,9 +513,17 @@ func (r *CouponResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeactivatePromotionCodesOnDelete.ValueBool() {
+		err = r.deactivatePromotionCodes(state.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate promotion codes, got error: %s", formatStripeError(err)))
+			return
+		}
+	}
+
 	_, err = r.sc.Coupons.Del(state.Id.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete coupon, got error: %s", formatStripeError(err)))
 		return
 	}
 }
@@ -340,22 +533,130 @@ func (r *CouponResource) ImportState(ctx context.Context, req resource.ImportSta
 	var coupon *stripe.Coupon
 	var err error
 
+	resp.Diagnostics.Append(checkImportID(req.ID, "coupon", "", true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := &stripe.CouponParams{}
 	params.AddExpand("currency_options")
 	coupon, err = r.sc.Coupons.Get(req.ID, params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import coupon, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(coupon.Object, "coupon", coupon.ID, "")...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state.Id = types.StringValue(req.ID)
-	r.populateModel(ctx, &state, coupon, resp.Diagnostics)
+	r.populateModel(ctx, &state, coupon, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *CouponResource) populateModel(ctx context.Context, model *CouponResourceModel, coupon *stripe.Coupon, respDiag diag.Diagnostics) {
+// deactivatePromotionCodes deactivates all active promotion codes of the
+// coupon with the given ID.
+func (r *CouponResource) deactivatePromotionCodes(couponID string) error {
+	params := &stripe.PromotionCodeListParams{
+		Active: stripe.Bool(true),
+		Coupon: stripe.String(couponID),
+	}
+	i := r.sc.PromotionCodes.List(params)
+	for i.Next() {
+		_, err := r.sc.PromotionCodes.Update(i.PromotionCode().ID, &stripe.PromotionCodeParams{
+			Active: stripe.Bool(false),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return i.Err()
+}
+
+// findMissingProducts returns the product IDs that do not exist in Stripe. The
+// products are listed in batches rather than fetched one by one.
+func (r *CouponResource) findMissingProducts(productIDs []string) ([]string, error) {
+	found := map[string]bool{}
+	for batch := range slices.Chunk(productIDs, 100) {
+		params := &stripe.ProductListParams{
+			IDs: stripe.StringSlice(batch),
+		}
+		params.Limit = stripe.Int64(100)
+		iter := r.sc.Products.List(params)
+		for iter.Next() {
+			found[iter.Product().ID] = true
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	var missing []string
+	for _, productID := range productIDs {
+		if !found[productID] {
+			missing = append(missing, productID)
+		}
+	}
+	return missing, nil
+}
+
+func (r *CouponResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateMovers("stripe_coupon", func(ctx context.Context, attrs map[string]any, resp *resource.MoveStateResponse) {
+		state := CouponResourceModel{
+			Id: movedString(attrs, "id"),
+			CurrencyOptions: types.MapNull(types.ObjectType{
+				AttrTypes: CouponCurrencyOptionsModel{}.Types(),
+			}),
+			Duration:         movedString(attrs, "duration"),
+			DurationInMonths: movedInt64(attrs, "duration_in_months"),
+			MaxRedemptions:   movedInt64(attrs, "max_redemptions"),
+			Name:             movedString(attrs, "name"),
+			PercentOff:       movedFloat64(attrs, "percent_off"),
+			RedeemBy:         movedTimestamp(attrs, "redeem_by"),
+			Valid:            movedBool(attrs, "valid"),
+		}
+
+		appliesTo, diags := types.ListValueFrom(ctx, types.StringType, movedStrings(attrs, "applies_to"))
+		resp.Diagnostics.Append(diags...)
+		state.AppliesTo = ListValueNullIfEmpty(appliesTo, types.StringType)
+
+		// The other providers take amount_off and currency at the top level,
+		// which is the top_level entry of currency_options here.
+		amountOff, currency := movedInt64(attrs, "amount_off"), movedString(attrs, "currency")
+		if !amountOff.IsNull() && !currency.IsNull() {
+			currencyOptions, diags := types.MapValueFrom(
+				ctx,
+				types.ObjectType{
+					AttrTypes: CouponCurrencyOptionsModel{}.Types(),
+				},
+				map[string]CouponCurrencyOptionsModel{
+					currency.ValueString(): {
+						AmountOff: amountOff,
+						TopLevel:  types.BoolValue(true),
+					},
+				},
+			)
+			resp.Diagnostics.Append(diags...)
+			state.CurrencyOptions = currencyOptions
+		}
+
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, movedStringMap(attrs, "metadata"))
+		resp.Diagnostics.Append(diags...)
+		state.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+		state.MetadataKeys = metadataKeys(state.Metadata)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	})
+}
+
+func (r *CouponResource) populateModel(ctx context.Context, model *CouponResourceModel, coupon *stripe.Coupon, respDiag *diag.Diagnostics) {
 	if coupon.AppliesTo != nil && coupon.AppliesTo.Products != nil {
 		appliesTo, diags := types.ListValueFrom(ctx, types.StringType, coupon.AppliesTo.Products)
 		if diags.HasError() {
@@ -398,9 +699,22 @@ func (r *CouponResource) populateModel(ctx context.Context, model *CouponResourc
 		respDiag.Append(diags...)
 	}
 	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.MetadataKeys = metadataKeys(model.Metadata)
 	model.Name = StringNullIfEmpty(coupon.Name)
 	model.PercentOff = Float64NullIfEmpty(coupon.PercentOff)
 	model.RedeemBy = Int64NullIfEmpty(coupon.RedeemBy)
+	model.Valid = types.BoolValue(coupon.Valid)
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"applies_to":         model.AppliesTo,
+		"currency_options":   model.CurrencyOptions,
+		"duration":           model.Duration,
+		"duration_in_months": model.DurationInMonths,
+		"max_redemptions":    model.MaxRedemptions,
+		"metadata":           model.Metadata,
+		"name":               model.Name,
+		"percent_off":        model.PercentOff,
+		"redeem_by":          model.RedeemBy,
+	})
 }
 
 func (r *CouponResource) buildCreateParams(ctx context.Context, data CouponResourceModel, respDiag diag.Diagnostics) *stripe.CouponParams {
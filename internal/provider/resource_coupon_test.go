@@ -2,15 +2,23 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v81"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const (
@@ -58,6 +66,38 @@ resource "stripe_coupon" "test" {
 	test = "test"
   }
 }
+`
+	testAccCouponResourceConfigMaxRedemptions string = `
+resource "stripe_coupon" "test" {
+  name = "test_updated_again"
+  currency_options = {
+    "usd" = {
+      amount_off = 2000
+      top_level = true
+    }
+  }
+  duration = "once"
+  max_redemptions = 10
+  metadata = {
+	test = "test"
+  }
+}
+`
+	testAccCouponResourceConfigMaxRedemptionsReplace string = `
+resource "stripe_coupon" "test" {
+  name = "test_updated_again"
+  currency_options = {
+    "usd" = {
+      amount_off = 2000
+      top_level = true
+    }
+  }
+  duration = "once"
+  max_redemptions = 20
+  metadata = {
+	test = "test"
+  }
+}
 `
 )
 
@@ -96,6 +136,24 @@ func TestAccCouponResource(t *testing.T) {
 					resource.TestCheckResourceAttr("stripe_coupon.test", "duration", "once"),
 				),
 			},
+			// Replace on max_redemptions change
+			{
+				Config: testAccCouponResourceConfigMaxRedemptions,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_coupon.test", "max_redemptions", "10"),
+				),
+			},
+			{
+				Config: testAccCouponResourceConfigMaxRedemptionsReplace,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stripe_coupon.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_coupon.test", "max_redemptions", "20"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -122,6 +180,7 @@ func TestPopulateModelCouponResource(t *testing.T) {
 				Name:             types.StringNull(),
 				PercentOff:       types.Float64Null(),
 				RedeemBy:         types.Int64Null(),
+				Valid:            types.BoolValue(false),
 			},
 		},
 		{
@@ -146,6 +205,7 @@ func TestPopulateModelCouponResource(t *testing.T) {
 				Name:       "test_name",
 				PercentOff: float64(25),
 				RedeemBy:   int64(1629484800),
+				Valid:      true,
 			},
 			want: CouponResourceModel{
 				AppliesTo: types.ListValueMust(types.StringType, []attr.Value{
@@ -170,6 +230,7 @@ func TestPopulateModelCouponResource(t *testing.T) {
 				Name:             types.StringValue("test_name"),
 				PercentOff:       types.Float64Value(25),
 				RedeemBy:         types.Int64Value(1629484800),
+				Valid:            types.BoolValue(true),
 			},
 		},
 	}
@@ -179,7 +240,7 @@ func TestPopulateModelCouponResource(t *testing.T) {
 			cr := &CouponResource{}
 			var model CouponResourceModel
 			diags := diag.Diagnostics{}
-			cr.populateModel(context.Background(), &model, tc.in, diags)
+			cr.populateModel(context.Background(), &model, tc.in, &diags)
 
 			if !assert.ElementsMatch(t, model.AppliesTo.Elements(), tc.want.AppliesTo.Elements()) {
 				t.Errorf("unexpected result for AppliesTo: %v", model.AppliesTo.Elements())
@@ -208,10 +269,111 @@ func TestPopulateModelCouponResource(t *testing.T) {
 			if !assert.Equal(t, model.RedeemBy, tc.want.RedeemBy) {
 				t.Errorf("unexpected result for RedeemBy: %v", model.RedeemBy)
 			}
+			if !assert.Equal(t, model.Valid, tc.want.Valid) {
+				t.Errorf("unexpected result for Valid: %v", model.Valid)
+			}
 		})
 	}
 }
 
+func TestReadCouponResource(t *testing.T) {
+	t.Run("Deleted coupon", func(t *testing.T) {
+		r := &CouponResource{
+			sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"type":"invalid_request_error","code":"resource_missing","message":"No such coupon: 'co_123'"}}`))
+			}),
+		}
+		state := testState(t, r, map[string]interface{}{
+			"id":       types.StringValue("co_123"),
+			"duration": types.StringValue("once"),
+		})
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		require.True(t, resp.State.Raw.IsNull())
+	})
+
+	t.Run("Expired coupon", func(t *testing.T) {
+		r := &CouponResource{
+			sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				_, _ = w.Write([]byte(`{"id":"co_123","object":"coupon","duration":"once","percent_off":25,"redeem_by":1629484800,"valid":false}`))
+			}),
+		}
+		state := testState(t, r, map[string]interface{}{
+			"id":       types.StringValue("co_123"),
+			"duration": types.StringValue("once"),
+			"valid":    types.BoolValue(true),
+		})
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		require.False(t, resp.State.Raw.IsNull())
+		var valid types.Bool
+		resp.State.GetAttribute(context.Background(), path.Root("valid"), &valid)
+		assert.Equal(t, types.BoolValue(false), valid)
+	})
+}
+
+func TestDeleteCouponResource(t *testing.T) {
+	t.Run("Deactivate promotion codes", func(t *testing.T) {
+		var requests []string
+		r := &CouponResource{
+			sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				require.NoError(t, req.ParseForm())
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				switch {
+				case req.Method == http.MethodGet && req.URL.Path == "/v1/promotion_codes":
+					assert.Equal(t, "co_123", req.Form.Get("coupon"))
+					assert.Equal(t, "true", req.Form.Get("active"))
+					_, _ = w.Write([]byte(`{"object":"list","url":"/v1/promotion_codes","has_more":false,"data":[{"id":"promo_1","object":"promotion_code","active":true},{"id":"promo_2","object":"promotion_code","active":true}]}`))
+				case req.Method == http.MethodPost:
+					assert.Equal(t, "false", req.PostForm.Get("active"))
+					_, _ = w.Write([]byte(`{"id":"promo","object":"promotion_code","active":false}`))
+				default:
+					_, _ = w.Write([]byte(`{"id":"co_123","object":"coupon","deleted":true}`))
+				}
+			}),
+		}
+		state := testState(t, r, map[string]interface{}{
+			"id":                                   types.StringValue("co_123"),
+			"deactivate_promotion_codes_on_delete": types.BoolValue(true),
+			"duration":                             types.StringValue("once"),
+		})
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{
+			"GET /v1/promotion_codes",
+			"POST /v1/promotion_codes/promo_1",
+			"POST /v1/promotion_codes/promo_2",
+			"DELETE /v1/coupons/co_123",
+		}, requests)
+	})
+
+	t.Run("Keep promotion codes", func(t *testing.T) {
+		var requests []string
+		r := &CouponResource{
+			sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+" "+req.URL.Path)
+				_, _ = w.Write([]byte(`{"id":"co_123","object":"coupon","deleted":true}`))
+			}),
+		}
+		state := testState(t, r, map[string]interface{}{
+			"id":       types.StringValue("co_123"),
+			"duration": types.StringValue("once"),
+		})
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		assert.Equal(t, []string{"DELETE /v1/coupons/co_123"}, requests)
+	})
+}
+
 func TestBuildCreateParamsCouponResource(t *testing.T) {
 	cases := []struct {
 		name string
@@ -459,3 +621,189 @@ func TestBuildUpdateParamsCouponResource(t *testing.T) {
 		})
 	}
 }
+
+func TestModifyPlanCouponResourceValidateAgainstAPI(t *testing.T) {
+	tests := []struct {
+		name        string
+		validate    bool
+		appliesTo   []string
+		status      int
+		expectError bool
+		expectCalls int
+	}{
+		{"disabled", false, []string{"prod_missing"}, http.StatusOK, false, 0},
+		{"no products", true, nil, http.StatusOK, false, 0},
+		{"products exist", true, []string{"prod_1", "prod_2"}, http.StatusOK, false, 1},
+		{"product missing", true, []string{"prod_1", "prod_missing"}, http.StatusOK, true, 1},
+		{"api error", true, []string{"prod_1"}, http.StatusUnauthorized, false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			sc := testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				w.WriteHeader(tt.status)
+				if tt.status != http.StatusOK {
+					_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "message": "Invalid API Key provided"}}`))
+					return
+				}
+				var data []string
+				for key, ids := range req.URL.Query() {
+					if strings.HasPrefix(key, "ids[") && ids[0] != "prod_missing" {
+						data = append(data, fmt.Sprintf(`{"id": %q, "object": "product"}`, ids[0]))
+					}
+				}
+				fmt.Fprintf(w, `{"object": "list", "data": [%s], "has_more": false}`, strings.Join(data, ","))
+			})
+			r := &CouponResource{
+				sc:           sc,
+				providerData: &StripeProviderData{Client: sc, ValidateAgainstAPI: tt.validate},
+			}
+			appliesTo := types.ListNull(types.StringType)
+			if tt.appliesTo != nil {
+				appliesTo = testListValue(t, types.StringType, tt.appliesTo)
+			}
+			req := fwresource.ModifyPlanRequest{
+				State: testState(t, r, nil),
+				Plan: testPlan(t, r, map[string]interface{}{
+					"applies_to":  appliesTo,
+					"duration":    types.StringValue("once"),
+					"percent_off": types.Float64Value(10),
+				}),
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.expectCalls, calls)
+			if tt.expectError {
+				assert.Equal(t, 1, resp.Diagnostics.ErrorsCount())
+			}
+		})
+	}
+}
+
+func TestConfigValidatorsCouponResource(t *testing.T) {
+	currencyOptions := func(topLevel map[string]bool) types.Map {
+		elements := map[string]attr.Value{}
+		for currency, isTopLevel := range topLevel {
+			elements[currency] = types.ObjectValueMust(CouponCurrencyOptionsModel{}.Types(), map[string]attr.Value{
+				"amount_off": types.Int64Value(500),
+				"top_level":  types.BoolValue(isTopLevel),
+			})
+		}
+		return types.MapValueMust(types.ObjectType{AttrTypes: CouponCurrencyOptionsModel{}.Types()}, elements)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		wantErr    bool
+		wantAttr   string
+	}{
+		{
+			name: "percent off",
+			attributes: map[string]interface{}{
+				"percent_off": types.Float64Value(10),
+			},
+		},
+		{
+			name: "currency options",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]bool{"usd": true, "eur": false}),
+			},
+		},
+		{
+			name: "repeating",
+			attributes: map[string]interface{}{
+				"duration":           types.StringValue("repeating"),
+				"duration_in_months": types.Int64Value(3),
+				"percent_off":        types.Float64Value(10),
+			},
+		},
+		{
+			name: "percent off and currency options",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]bool{"usd": true}),
+				"percent_off":      types.Float64Value(10),
+			},
+			wantErr:  true,
+			wantAttr: "currency_options",
+		},
+		{
+			name:       "no discount",
+			attributes: map[string]interface{}{},
+			wantErr:    true,
+		},
+		{
+			name: "no top level currency option",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]bool{"usd": false, "eur": false}),
+			},
+			wantErr:  true,
+			wantAttr: "currency_options",
+		},
+		{
+			name: "several top level currency options",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]bool{"usd": true, "eur": true}),
+			},
+			wantErr:  true,
+			wantAttr: "currency_options",
+		},
+		{
+			name: "repeating without duration in months",
+			attributes: map[string]interface{}{
+				"duration":    types.StringValue("repeating"),
+				"percent_off": types.Float64Value(10),
+			},
+			wantErr:  true,
+			wantAttr: "duration_in_months",
+		},
+		{
+			name: "duration in months without repeating",
+			attributes: map[string]interface{}{
+				"duration":           types.StringValue("forever"),
+				"duration_in_months": types.Int64Value(3),
+				"percent_off":        types.Float64Value(10),
+			},
+			wantErr:  true,
+			wantAttr: "duration_in_months",
+		},
+		{
+			name: "duration in months with default duration",
+			attributes: map[string]interface{}{
+				"duration_in_months": types.Int64Value(3),
+				"percent_off":        types.Float64Value(10),
+			},
+			wantErr:  true,
+			wantAttr: "duration_in_months",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CouponResource{}
+			plan := testPlan(t, r, tt.attributes)
+			req := fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(context.Background()) {
+				v.ValidateResource(context.Background(), req, resp)
+			}
+
+			if !tt.wantErr {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+			if tt.wantAttr != "" {
+				withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+				require.True(t, ok, resp.Diagnostics)
+				assert.Equal(t, path.Root(tt.wantAttr), withPath.Path())
+			}
+		})
+	}
+}
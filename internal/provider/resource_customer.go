@@ -0,0 +1,605 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerResource{}
+var _ resource.ResourceWithImportState = &CustomerResource{}
+
+// invoicePrefixRegexp matches an invoice prefix as Stripe accepts it: 3 to 12
+// uppercase letters or numbers.
+var invoicePrefixRegexp = regexp.MustCompile(`^[A-Z0-9]{3,12}$`)
+
+func NewCustomerResource() resource.Resource {
+	return &CustomerResource{}
+}
+
+// CustomerResource defines the resource implementation.
+type CustomerResource struct {
+	sc           *client.API
+	providerData *StripeProviderData
+}
+
+// CustomerResourceModel describes the resource data model.
+type CustomerResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Address          types.Object `tfsdk:"address"`
+	ConfigHash       types.String `tfsdk:"config_hash"`
+	Description      types.String `tfsdk:"description"`
+	Email            types.String `tfsdk:"email"`
+	InvoicePrefix    types.String `tfsdk:"invoice_prefix"`
+	Metadata         types.Map    `tfsdk:"metadata"`
+	MetadataKeys     types.List   `tfsdk:"metadata_keys"`
+	Name             types.String `tfsdk:"name"`
+	Phone            types.String `tfsdk:"phone"`
+	PreferredLocales types.List   `tfsdk:"preferred_locales"`
+	RequireLivemode  types.Bool   `tfsdk:"require_livemode"`
+	Shipping         types.Object `tfsdk:"shipping"`
+	TaxExempt        types.String `tfsdk:"tax_exempt"`
+}
+
+type CustomerAddressModel struct {
+	City       types.String `tfsdk:"city"`
+	Country    types.String `tfsdk:"country"`
+	Line1      types.String `tfsdk:"line1"`
+	Line2      types.String `tfsdk:"line2"`
+	PostalCode types.String `tfsdk:"postal_code"`
+	State      types.String `tfsdk:"state"`
+}
+
+func (m CustomerAddressModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"city":        types.StringType,
+		"country":     types.StringType,
+		"line1":       types.StringType,
+		"line2":       types.StringType,
+		"postal_code": types.StringType,
+		"state":       types.StringType,
+	}
+}
+
+type CustomerShippingModel struct {
+	Address types.Object `tfsdk:"address"`
+	Name    types.String `tfsdk:"name"`
+	Phone   types.String `tfsdk:"phone"`
+}
+
+func (m CustomerShippingModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": types.ObjectType{
+			AttrTypes: CustomerAddressModel{}.Types(),
+		},
+		"name":  types.StringType,
+		"phone": types.StringType,
+	}
+}
+
+func (r *CustomerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer"
+}
+
+func (r *CustomerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	addressAttributes := map[string]schema.Attribute{
+		"city": schema.StringAttribute{
+			MarkdownDescription: "City, district, suburb, town, or village.",
+			Optional:            true,
+		},
+		"country": schema.StringAttribute{
+			MarkdownDescription: "Two-letter country code ([ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)).",
+			Optional:            true,
+		},
+		"line1": schema.StringAttribute{
+			MarkdownDescription: "Address line 1 (e.g., street, PO Box, or company name).",
+			Optional:            true,
+		},
+		"line2": schema.StringAttribute{
+			MarkdownDescription: "Address line 2 (e.g., apartment, suite, unit, or building).",
+			Optional:            true,
+		},
+		"postal_code": schema.StringAttribute{
+			MarkdownDescription: "ZIP or postal code.",
+			Optional:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "State, county, province, or region.",
+			Optional:            true,
+		},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Customers represent the people and businesses you charge, and let you track their payments, subscriptions and invoices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.SingleNestedAttribute{
+				MarkdownDescription: "The customer's address.",
+				Optional:            true,
+				Attributes:          addressAttributes,
+			},
+			"config_hash": configHashAttribute(),
+			"description": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary string attached to the object. Often useful for displaying to users.",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The customer's email address.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(512),
+				},
+			},
+			"invoice_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix for the customer used to generate unique invoice numbers. Must be 3–12 uppercase letters or numbers. Generated by Stripe when not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(invoicePrefixRegexp, "must be 3 to 12 uppercase letters or numbers"),
+				},
+			},
+			"metadata":      metadataAttribute(),
+			"metadata_keys": metadataKeysAttribute(),
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The customer's full name or business name.",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "The customer's phone number.",
+				Optional:            true,
+			},
+			"preferred_locales": schema.ListAttribute{
+				MarkdownDescription: "Customer's preferred languages, ordered by preference.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"require_livemode": requireLivemodeAttribute(),
+			"shipping": schema.SingleNestedAttribute{
+				MarkdownDescription: "The customer's shipping information. Appears on invoices emailed to this customer.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"address": schema.SingleNestedAttribute{
+						MarkdownDescription: "Customer shipping address.",
+						Required:            true,
+						Attributes:          addressAttributes,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Customer name.",
+						Required:            true,
+					},
+					"phone": schema.StringAttribute{
+						MarkdownDescription: "Customer phone (including extension).",
+						Optional:            true,
+					},
+				},
+			},
+			"tax_exempt": schema.StringAttribute{
+				MarkdownDescription: "The customer's tax exemption. One of `none`, `exempt`, or `reverse`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("none"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("none", "exempt", "reverse"),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *CustomerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomerResourceModel
+	var customer *stripe.Customer
+	var err error
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.buildCreateParams(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer, err = r.sc.Customers.New(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create customer, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	plan.Id = types.StringValue(customer.ID)
+	var populateDiags diag.Diagnostics
+	r.populateModel(ctx, &plan, customer, &populateDiags)
+	resp.Diagnostics.Append(populateDiags...)
+	if populateDiags.HasError() {
+		// Keep track of the created customer so that it is not leaked. The
+		// error taints it, so it is replaced on the next apply.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+		return
+	}
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomerResourceModel
+	var customer *stripe.Customer
+	var err error
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer, err = r.sc.Customers.Get(state.Id.ValueString(), nil)
+	// Stripe keeps returning deleted customers, flagged as deleted.
+	if isNotFound(err) || (err == nil && customer.Deleted) {
+		tflog.Warn(ctx, "Customer not found, removing from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(customer.Object, "customer", customer.ID, "cus_")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.populateModel(ctx, &state, customer, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan CustomerResourceModel
+	var customer *stripe.Customer
+	var err error
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := r.buildUpdateParams(ctx, state, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer, err = r.sc.Customers.Update(plan.Id.ValueString(), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update customer, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	r.populateModel(ctx, &plan, customer, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CustomerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomerResourceModel
+	var err error
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.sc.Customers.Del(state.Id.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete customer, got error: %s", formatStripeError(err)))
+		return
+	}
+}
+
+func (r *CustomerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state CustomerResourceModel
+	var customer *stripe.Customer
+	var err error
+
+	resp.Diagnostics.Append(checkImportID(req.ID, "customer", "cus_", false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customer, err = r.sc.Customers.Get(req.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import customer, got error: %s", formatStripeError(err)))
+		return
+	}
+	if customer.Deleted {
+		resp.Diagnostics.AddError("Cannot Import Deleted Customer", fmt.Sprintf("The customer %s has been deleted.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(customer.Object, "customer", customer.ID, "cus_")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(req.ID)
+	r.populateModel(ctx, &state, customer, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CustomerResource) populateModel(ctx context.Context, model *CustomerResourceModel, customer *stripe.Customer, respDiag *diag.Diagnostics) {
+	model.Address = r.populateAddress(ctx, customer.Address, respDiag)
+	model.Description = StringNullIfEmpty(customer.Description)
+	model.Email = StringNullIfEmpty(customer.Email)
+	model.InvoicePrefix = StringNullIfEmpty(customer.InvoicePrefix)
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, customer.Metadata)
+	respDiag.Append(diags...)
+	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.MetadataKeys = metadataKeys(model.Metadata)
+	model.Name = StringNullIfEmpty(customer.Name)
+	model.Phone = StringNullIfEmpty(customer.Phone)
+	preferredLocales, diags := types.ListValueFrom(ctx, types.StringType, customer.PreferredLocales)
+	respDiag.Append(diags...)
+	model.PreferredLocales = ListValueNullIfEmpty(preferredLocales, types.StringType)
+	model.Shipping = types.ObjectNull(CustomerShippingModel{}.Types())
+	if customer.Shipping != nil {
+		shipping, diags := types.ObjectValueFrom(ctx, CustomerShippingModel{}.Types(), CustomerShippingModel{
+			Address: r.populateAddress(ctx, customer.Shipping.Address, respDiag),
+			Name:    types.StringValue(customer.Shipping.Name),
+			Phone:   StringNullIfEmpty(customer.Shipping.Phone),
+		})
+		respDiag.Append(diags...)
+		model.Shipping = shipping
+	}
+	model.TaxExempt = types.StringValue(string(customer.TaxExempt))
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"address":           model.Address,
+		"description":       model.Description,
+		"email":             model.Email,
+		"invoice_prefix":    model.InvoicePrefix,
+		"metadata":          model.Metadata,
+		"name":              model.Name,
+		"phone":             model.Phone,
+		"preferred_locales": model.PreferredLocales,
+		"shipping":          model.Shipping,
+		"tax_exempt":        model.TaxExempt,
+	})
+}
+
+// populateAddress builds an address from the one Stripe returned. Stripe
+// returns an address with all fields empty for a removed address, which is
+// null like a missing one.
+func (r *CustomerResource) populateAddress(ctx context.Context, address *stripe.Address, respDiag *diag.Diagnostics) types.Object {
+	if address == nil || *address == (stripe.Address{}) {
+		return types.ObjectNull(CustomerAddressModel{}.Types())
+	}
+
+	object, diags := types.ObjectValueFrom(ctx, CustomerAddressModel{}.Types(), CustomerAddressModel{
+		City:       StringNullIfEmpty(address.City),
+		Country:    StringNullIfEmpty(address.Country),
+		Line1:      StringNullIfEmpty(address.Line1),
+		Line2:      StringNullIfEmpty(address.Line2),
+		PostalCode: StringNullIfEmpty(address.PostalCode),
+		State:      StringNullIfEmpty(address.State),
+	})
+	respDiag.Append(diags...)
+	return object
+}
+
+// buildAddressParams returns the params of a configured address, or nil if
+// the address is not set.
+func (r *CustomerResource) buildAddressParams(ctx context.Context, address types.Object, respDiag *diag.Diagnostics) *stripe.AddressParams {
+	if address.IsUnknown() || address.IsNull() {
+		return nil
+	}
+
+	var model CustomerAddressModel
+	diags := address.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	return &stripe.AddressParams{
+		City:       model.City.ValueStringPointer(),
+		Country:    model.Country.ValueStringPointer(),
+		Line1:      model.Line1.ValueStringPointer(),
+		Line2:      model.Line2.ValueStringPointer(),
+		PostalCode: model.PostalCode.ValueStringPointer(),
+		State:      model.State.ValueStringPointer(),
+	}
+}
+
+// buildShippingParams returns the params of configured shipping information,
+// or nil if it is not set.
+func (r *CustomerResource) buildShippingParams(ctx context.Context, shipping types.Object, respDiag *diag.Diagnostics) *stripe.CustomerShippingParams {
+	if shipping.IsUnknown() || shipping.IsNull() {
+		return nil
+	}
+
+	var model CustomerShippingModel
+	diags := shipping.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	return &stripe.CustomerShippingParams{
+		Address: r.buildAddressParams(ctx, model.Address, respDiag),
+		Name:    model.Name.ValueStringPointer(),
+		Phone:   model.Phone.ValueStringPointer(),
+	}
+}
+
+func (r *CustomerResource) buildCreateParams(ctx context.Context, plan CustomerResourceModel, respDiag *diag.Diagnostics) *stripe.CustomerParams {
+	params := &stripe.CustomerParams{}
+	params.Address = r.buildAddressParams(ctx, plan.Address, respDiag)
+	if !plan.Description.IsUnknown() {
+		params.Description = plan.Description.ValueStringPointer()
+	}
+	if !plan.Email.IsUnknown() {
+		params.Email = plan.Email.ValueStringPointer()
+	}
+	if !plan.InvoicePrefix.IsUnknown() {
+		params.InvoicePrefix = plan.InvoicePrefix.ValueStringPointer()
+	}
+	if !plan.Metadata.IsNull() {
+		for k, v := range plan.Metadata.Elements() {
+			if str, ok := v.(types.String); ok {
+				params.AddMetadata(k, str.ValueString())
+			}
+		}
+	}
+	if !plan.Name.IsUnknown() {
+		params.Name = plan.Name.ValueStringPointer()
+	}
+	if !plan.Phone.IsUnknown() {
+		params.Phone = plan.Phone.ValueStringPointer()
+	}
+	params.PreferredLocales = convertListToStringPtrs(plan.PreferredLocales)
+	params.Shipping = r.buildShippingParams(ctx, plan.Shipping, respDiag)
+	if !plan.TaxExempt.IsUnknown() {
+		params.TaxExempt = plan.TaxExempt.ValueStringPointer()
+	}
+	return params
+}
+
+func (r *CustomerResource) buildUpdateParams(ctx context.Context, state, plan CustomerResourceModel, respDiag *diag.Diagnostics) *stripe.CustomerParams {
+	params := &stripe.CustomerParams{}
+	// Stripe unsets an address, and shipping information, given as an empty
+	// string.
+	if !plan.Address.IsUnknown() && !plan.Address.Equal(state.Address) {
+		if plan.Address.IsNull() {
+			params.AddExtra("address", "")
+		} else {
+			params.Address = r.buildAddressParams(ctx, plan.Address, respDiag)
+		}
+	}
+	if !plan.Description.Equal(state.Description) {
+		params.Description = EmptyStringIfNull(plan.Description)
+	}
+	if !plan.Email.Equal(state.Email) {
+		params.Email = EmptyStringIfNull(plan.Email)
+	}
+	// Stripe keeps the invoice prefix once it is set, so a removed prefix is
+	// left in place.
+	if !plan.InvoicePrefix.IsUnknown() && !plan.InvoicePrefix.IsNull() && !plan.InvoicePrefix.Equal(state.InvoicePrefix) {
+		params.InvoicePrefix = plan.InvoicePrefix.ValueStringPointer()
+	}
+	if !plan.Metadata.Equal(state.Metadata) {
+		planMetadata := plan.Metadata.Elements()
+		stateMetadata := state.Metadata.Elements()
+		for k, v := range planMetadata {
+			if str, ok := v.(types.String); ok {
+				params.AddMetadata(k, str.ValueString())
+			}
+		}
+		for k := range stateMetadata {
+			if _, exists := planMetadata[k]; !exists {
+				params.AddMetadata(k, "")
+			}
+		}
+	}
+	if !plan.Name.Equal(state.Name) {
+		params.Name = EmptyStringIfNull(plan.Name)
+	}
+	if !plan.Phone.Equal(state.Phone) {
+		params.Phone = EmptyStringIfNull(plan.Phone)
+	}
+	if !plan.PreferredLocales.Equal(state.PreferredLocales) {
+		// An empty list clears the preferred locales.
+		params.PreferredLocales = []*string{}
+		if !plan.PreferredLocales.IsNull() {
+			params.PreferredLocales = convertListToStringPtrs(plan.PreferredLocales)
+		}
+	}
+	if !plan.Shipping.IsUnknown() && !plan.Shipping.Equal(state.Shipping) {
+		if plan.Shipping.IsNull() {
+			params.AddExtra("shipping", "")
+		} else {
+			params.Shipping = r.buildShippingParams(ctx, plan.Shipping, respDiag)
+		}
+	}
+	if !plan.TaxExempt.IsUnknown() && !plan.TaxExempt.Equal(state.TaxExempt) {
+		params.TaxExempt = plan.TaxExempt.ValueStringPointer()
+	}
+	return params
+}
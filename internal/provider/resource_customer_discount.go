@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomerDiscountResource{}
+var _ resource.ResourceWithConfigValidators = &CustomerDiscountResource{}
+var _ resource.ResourceWithImportState = &CustomerDiscountResource{}
+
+func NewCustomerDiscountResource() resource.Resource {
+	return &CustomerDiscountResource{}
+}
+
+// CustomerDiscountResource defines the resource implementation.
+type CustomerDiscountResource struct {
+	sc           *client.API
+	providerData *StripeProviderData
+}
+
+// CustomerDiscountResourceModel describes the resource data model.
+type CustomerDiscountResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ConfigHash      types.String `tfsdk:"config_hash"`
+	Coupon          types.String `tfsdk:"coupon"`
+	Customer        types.String `tfsdk:"customer"`
+	End             types.Int64  `tfsdk:"end"`
+	PromotionCode   types.String `tfsdk:"promotion_code"`
+	RequireLivemode types.Bool   `tfsdk:"require_livemode"`
+	Start           types.Int64  `tfsdk:"start"`
+	Subscription    types.String `tfsdk:"subscription"`
+}
+
+func (r *CustomerDiscountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_discount"
+}
+
+func (r *CustomerDiscountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Applies a coupon or promotion code as the discount of a customer or subscription. Discounts cannot be edited, so any change replaces the discount. Import using the ID of the customer (`cus_...`) or subscription (`sub_...`).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the discount object.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_hash": configHashAttribute(),
+			"coupon": schema.StringAttribute{
+				MarkdownDescription: "The ID of the coupon to apply. Exactly one of `coupon` or `promotion_code` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"customer": schema.StringAttribute{
+				MarkdownDescription: "The ID of the customer to apply the discount to. Exactly one of `customer` or `subscription` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end": schema.Int64Attribute{
+				MarkdownDescription: "If the coupon has a duration of `repeating`, the date that this discount will end. Measured in seconds since the Unix epoch.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"promotion_code": schema.StringAttribute{
+				MarkdownDescription: "The ID of the promotion code to apply. Exactly one of `coupon` or `promotion_code` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"require_livemode": requireLivemodeAttribute(),
+			"start": schema.Int64Attribute{
+				MarkdownDescription: "Date that the discount was applied. Measured in seconds since the Unix epoch.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subscription to apply the discount to. Exactly one of `customer` or `subscription` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomerDiscountResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("customer"),
+			path.MatchRoot("subscription"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("coupon"),
+			path.MatchRoot("promotion_code"),
+		),
+	}
+}
+
+func (r *CustomerDiscountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*StripeProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *CustomerDiscountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomerDiscountResourceModel
+	var discount *stripe.Discount
+	var err error
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Customer.IsNull() {
+		var customer *stripe.Customer
+		customer, err = r.sc.Customers.Update(plan.Customer.ValueString(), &stripe.CustomerParams{
+			Coupon:        plan.Coupon.ValueStringPointer(),
+			PromotionCode: plan.PromotionCode.ValueStringPointer(),
+		})
+		if err == nil {
+			discount = customer.Discount
+		}
+	} else {
+		var subscription *stripe.Subscription
+		subscription, err = r.sc.Subscriptions.Update(plan.Subscription.ValueString(), &stripe.SubscriptionParams{
+			Coupon:        plan.Coupon.ValueStringPointer(),
+			PromotionCode: plan.PromotionCode.ValueStringPointer(),
+		})
+		if err == nil {
+			discount = subscription.Discount
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply discount, got error: %s", formatStripeError(err)))
+		return
+	}
+	if discount == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to apply discount, Stripe returned no discount.")
+		return
+	}
+
+	r.populateModel(&plan, discount)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CustomerDiscountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomerDiscountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	discount, err := r.getDiscount(state)
+	if isNotFound(err) {
+		discount, err = nil, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read discount, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	// The discount was removed or replaced outside of Terraform.
+	if discount == nil || discount.ID != state.Id.ValueString() {
+		tflog.Warn(ctx, "Discount not found, removing from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.populateModel(&state, discount)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CustomerDiscountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan CustomerDiscountResourceModel
+
+	// Every discount attribute requires replacement, so only provider-side
+	// attributes such as require_livemode can change here. Computed
+	// attributes like config_hash are unknown in the plan and are kept from
+	// the prior state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.RequireLivemode = plan.RequireLivemode
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *CustomerDiscountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomerDiscountResourceModel
+	var err error
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Customer.IsNull() {
+		_, err = r.sc.Customers.DeleteDiscount(state.Customer.ValueString(), nil)
+	} else {
+		_, err = r.sc.Subscriptions.DeleteDiscount(state.Subscription.ValueString(), nil)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete discount, got error: %s", formatStripeError(err)))
+		return
+	}
+}
+
+func (r *CustomerDiscountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state CustomerDiscountResourceModel
+
+	switch {
+	case strings.HasPrefix(req.ID, "cus_"):
+		state.Customer = types.StringValue(req.ID)
+	case strings.HasPrefix(req.ID, "sub_"):
+		state.Subscription = types.StringValue(req.ID)
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected the ID of a customer (cus_...) or subscription (sub_...), got: %q", req.ID),
+		)
+		return
+	}
+
+	discount, err := r.getDiscount(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import discount, got error: %s", formatStripeError(err)))
+		return
+	}
+	if discount == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import discount, %s has no discount.", req.ID))
+		return
+	}
+
+	r.populateModel(&state, discount)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// getDiscount returns the current discount of the customer or subscription
+// of model, or nil if it has none.
+func (r *CustomerDiscountResource) getDiscount(model CustomerDiscountResourceModel) (*stripe.Discount, error) {
+	if !model.Customer.IsNull() {
+		customer, err := r.sc.Customers.Get(model.Customer.ValueString(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if customer.Deleted {
+			return nil, nil
+		}
+		return customer.Discount, nil
+	}
+
+	subscription, err := r.sc.Subscriptions.Get(model.Subscription.ValueString(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return subscription.Discount, nil
+}
+
+func (r *CustomerDiscountResource) populateModel(model *CustomerDiscountResourceModel, discount *stripe.Discount) {
+	model.Id = types.StringValue(discount.ID)
+	// A discount created from a promotion code also references its coupon,
+	// which is only kept when the discount was configured with the coupon.
+	if discount.PromotionCode != nil {
+		model.PromotionCode = types.StringValue(discount.PromotionCode.ID)
+	} else {
+		model.PromotionCode = types.StringNull()
+		if discount.Coupon != nil {
+			model.Coupon = types.StringValue(discount.Coupon.ID)
+		}
+	}
+	model.End = Int64NullIfEmpty(discount.End)
+	model.Start = Int64NullIfEmpty(discount.Start)
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"coupon":         model.Coupon,
+		"customer":       model.Customer,
+		"promotion_code": model.PromotionCode,
+		"subscription":   model.Subscription,
+	})
+}
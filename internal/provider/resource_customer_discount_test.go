@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestCreateCustomerDiscountResource(t *testing.T) {
+	tests := []struct {
+		name         string
+		attributes   map[string]interface{}
+		response     string
+		expectedPath string
+		expectedForm url.Values
+		expected     CustomerDiscountResourceModel
+	}{
+		{
+			name: "customer coupon",
+			attributes: map[string]interface{}{
+				"customer": types.StringValue("cus_123"),
+				"coupon":   types.StringValue("SUMMER"),
+			},
+			response:     `{"id":"cus_123","object":"customer","discount":{"id":"di_123","object":"discount","coupon":{"id":"SUMMER","object":"coupon"},"customer":"cus_123","start":1700000000}}`,
+			expectedPath: "/v1/customers/cus_123",
+			expectedForm: url.Values{"coupon": {"SUMMER"}},
+			expected: CustomerDiscountResourceModel{
+				Id:              types.StringValue("di_123"),
+				Coupon:          types.StringValue("SUMMER"),
+				Customer:        types.StringValue("cus_123"),
+				End:             types.Int64Null(),
+				PromotionCode:   types.StringNull(),
+				RequireLivemode: types.BoolNull(),
+				Start:           types.Int64Value(1700000000),
+				Subscription:    types.StringNull(),
+			},
+		},
+		{
+			name: "subscription promotion code",
+			attributes: map[string]interface{}{
+				"subscription":   types.StringValue("sub_123"),
+				"promotion_code": types.StringValue("promo_123"),
+			},
+			response:     `{"id":"sub_123","object":"subscription","discount":{"id":"di_123","object":"discount","coupon":{"id":"SUMMER","object":"coupon"},"promotion_code":"promo_123","subscription":"sub_123","start":1700000000,"end":1710000000}}`,
+			expectedPath: "/v1/subscriptions/sub_123",
+			expectedForm: url.Values{"promotion_code": {"promo_123"}},
+			expected: CustomerDiscountResourceModel{
+				Id:              types.StringValue("di_123"),
+				Coupon:          types.StringNull(),
+				Customer:        types.StringNull(),
+				End:             types.Int64Value(1710000000),
+				PromotionCode:   types.StringValue("promo_123"),
+				RequireLivemode: types.BoolNull(),
+				Start:           types.Int64Value(1700000000),
+				Subscription:    types.StringValue("sub_123"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestPath string
+			var form url.Values
+			r := &CustomerDiscountResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					require.NoError(t, req.ParseForm())
+					requestPath = req.URL.Path
+					form = req.PostForm
+					_, _ = w.Write([]byte(tt.response))
+				}),
+			}
+			plan := testPlan(t, r, tt.attributes)
+			resp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			assert.Equal(t, tt.expectedPath, requestPath)
+			assert.Equal(t, tt.expectedForm, form)
+
+			var model CustomerDiscountResourceModel
+			require.False(t, resp.State.Get(context.Background(), &model).HasError())
+			tt.expected.ConfigHash = model.ConfigHash
+			assert.Equal(t, tt.expected, model)
+		})
+	}
+}
+
+func TestReadCustomerDiscountResourceRemoved(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+	}{
+		{"discount removed", http.StatusOK, `{"id":"cus_123","object":"customer","discount":null}`},
+		{"discount replaced", http.StatusOK, `{"id":"cus_123","object":"customer","discount":{"id":"di_456","object":"discount","coupon":{"id":"WINTER","object":"coupon"}}}`},
+		{"customer deleted", http.StatusOK, `{"id":"cus_123","object":"customer","deleted":true}`},
+		{"customer not found", http.StatusNotFound, `{"error":{"type":"invalid_request_error","code":"resource_missing","message":"No such customer"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CustomerDiscountResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.response))
+				}),
+			}
+			state := testState(t, r, map[string]interface{}{
+				"id":       types.StringValue("di_123"),
+				"coupon":   types.StringValue("SUMMER"),
+				"customer": types.StringValue("cus_123"),
+			})
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestUpdateCustomerDiscountResourceRequireLivemode(t *testing.T) {
+	r := &CustomerDiscountResource{}
+	state := testState(t, r, map[string]interface{}{
+		"id":          types.StringValue("di_123"),
+		"config_hash": types.StringValue("hash"),
+		"coupon":      types.StringValue("SUMMER"),
+		"customer":    types.StringValue("cus_123"),
+		"start":       types.Int64Value(1700000000),
+	})
+	plan := testPlan(t, r, map[string]interface{}{
+		"id":               types.StringValue("di_123"),
+		"config_hash":      types.StringUnknown(),
+		"coupon":           types.StringValue("SUMMER"),
+		"customer":         types.StringValue("cus_123"),
+		"require_livemode": types.BoolValue(false),
+		"start":            types.Int64Value(1700000000),
+	})
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var model CustomerDiscountResourceModel
+	require.False(t, resp.State.Get(context.Background(), &model).HasError())
+	assert.Equal(t, types.StringValue("hash"), model.ConfigHash)
+	assert.Equal(t, types.BoolValue(false), model.RequireLivemode)
+}
+
+func TestDeleteCustomerDiscountResource(t *testing.T) {
+	var method, requestPath string
+	r := &CustomerDiscountResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			method = req.Method
+			requestPath = req.URL.Path
+			_, _ = w.Write([]byte(`{"id":"di_123","object":"discount","deleted":true}`))
+		}),
+	}
+	state := testState(t, r, map[string]interface{}{
+		"id":           types.StringValue("di_123"),
+		"coupon":       types.StringValue("SUMMER"),
+		"subscription": types.StringValue("sub_123"),
+	})
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(context.Background(), fwresource.DeleteRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal(t, http.MethodDelete, method)
+	assert.Equal(t, "/v1/subscriptions/sub_123/discount", requestPath)
+}
+
+func TestPopulateModelCustomerDiscountResource(t *testing.T) {
+	r := &CustomerDiscountResource{}
+	model := CustomerDiscountResourceModel{
+		Coupon:   types.StringValue("SUMMER"),
+		Customer: types.StringValue("cus_123"),
+	}
+	r.populateModel(&model, &stripe.Discount{
+		ID:     "di_123",
+		Coupon: &stripe.Coupon{ID: "SUMMER"},
+		Start:  1700000000,
+	})
+
+	assert.Equal(t, types.StringValue("di_123"), model.Id)
+	assert.Equal(t, types.StringValue("SUMMER"), model.Coupon)
+	assert.Equal(t, types.StringNull(), model.PromotionCode)
+	assert.Equal(t, types.Int64Null(), model.End)
+	assert.Equal(t, types.Int64Value(1700000000), model.Start)
+	assert.False(t, model.ConfigHash.IsNull())
+}
@@ -0,0 +1,412 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	testAccCustomerResourceConfigCreate string = `
+resource "stripe_customer" "test" {
+  name  = "test"
+  email = "test@example.com"
+  address = {
+    line1       = "1 Test Street"
+    city        = "Berlin"
+    postal_code = "10115"
+    country     = "DE"
+  }
+  metadata = {
+	test = "test"
+  }
+}
+`
+	testAccCustomerResourceConfigUpdate string = `
+resource "stripe_customer" "test" {
+  name       = "test_updated"
+  email      = "test@example.com"
+  tax_exempt = "reverse"
+  preferred_locales = ["de", "en"]
+}
+`
+)
+
+func TestAccCustomerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCustomerResourceConfigCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_customer.test", "name", "test"),
+					resource.TestCheckResourceAttr("stripe_customer.test", "address.city", "Berlin"),
+					resource.TestCheckResourceAttr("stripe_customer.test", "tax_exempt", "none"),
+					resource.TestCheckResourceAttrSet("stripe_customer.test", "invoice_prefix"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "stripe_customer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCustomerResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_customer.test", "name", "test_updated"),
+					resource.TestCheckNoResourceAttr("stripe_customer.test", "address"),
+					resource.TestCheckNoResourceAttr("stripe_customer.test", "metadata"),
+					resource.TestCheckResourceAttr("stripe_customer.test", "tax_exempt", "reverse"),
+					resource.TestCheckResourceAttr("stripe_customer.test", "preferred_locales.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testCustomerAddressModel(t *testing.T, line1, city, country string) types.Object {
+	t.Helper()
+	object, diags := types.ObjectValueFrom(context.Background(), CustomerAddressModel{}.Types(), CustomerAddressModel{
+		City:       types.StringValue(city),
+		Country:    types.StringValue(country),
+		Line1:      types.StringValue(line1),
+		Line2:      types.StringNull(),
+		PostalCode: types.StringNull(),
+		State:      types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to construct address: %s", diags)
+	}
+	return object
+}
+
+func testCustomerShippingModel(t *testing.T, name string, address types.Object) types.Object {
+	t.Helper()
+	object, diags := types.ObjectValueFrom(context.Background(), CustomerShippingModel{}.Types(), CustomerShippingModel{
+		Address: address,
+		Name:    types.StringValue(name),
+		Phone:   types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to construct shipping: %s", diags)
+	}
+	return object
+}
+
+func TestPopulateModelCustomerResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		customer *stripe.Customer
+		expected CustomerResourceModel
+	}{
+		{
+			name: "All fields filled",
+			customer: &stripe.Customer{
+				Address:          &stripe.Address{Line1: "1 Test Street", City: "Berlin", Country: "DE"},
+				Description:      "A customer",
+				Email:            "test@example.com",
+				InvoicePrefix:    "ABC123",
+				Metadata:         map[string]string{"foo": "bar"},
+				Name:             "Customer 1",
+				Phone:            "+4930123456",
+				PreferredLocales: []string{"de", "en"},
+				Shipping: &stripe.ShippingDetails{
+					Address: &stripe.Address{Line1: "2 Test Street", City: "Hamburg", Country: "DE"},
+					Name:    "Recipient",
+				},
+				TaxExempt: stripe.CustomerTaxExemptReverse,
+			},
+			expected: CustomerResourceModel{
+				Address:          testCustomerAddressModel(t, "1 Test Street", "Berlin", "DE"),
+				Description:      types.StringValue("A customer"),
+				Email:            types.StringValue("test@example.com"),
+				InvoicePrefix:    types.StringValue("ABC123"),
+				Metadata:         testMapValue(t, types.StringType, map[string]interface{}{"foo": "bar"}),
+				MetadataKeys:     testListValue(t, types.StringType, []string{"foo"}),
+				Name:             types.StringValue("Customer 1"),
+				Phone:            types.StringValue("+4930123456"),
+				PreferredLocales: testListValue(t, types.StringType, []string{"de", "en"}),
+				Shipping:         testCustomerShippingModel(t, "Recipient", testCustomerAddressModel(t, "2 Test Street", "Hamburg", "DE")),
+				TaxExempt:        types.StringValue("reverse"),
+			},
+		},
+		{
+			name: "Empty fields",
+			customer: &stripe.Customer{
+				Address:       &stripe.Address{},
+				InvoicePrefix: "ABC123",
+				Metadata:      map[string]string{},
+				TaxExempt:     stripe.CustomerTaxExemptNone,
+			},
+			expected: CustomerResourceModel{
+				Address:          types.ObjectNull(CustomerAddressModel{}.Types()),
+				Description:      types.StringNull(),
+				Email:            types.StringNull(),
+				InvoicePrefix:    types.StringValue("ABC123"),
+				Metadata:         testMapValue(t, types.StringType, nil),
+				MetadataKeys:     testListValue(t, types.StringType, []string{}),
+				Name:             types.StringNull(),
+				Phone:            types.StringNull(),
+				PreferredLocales: types.ListNull(types.StringType),
+				Shipping:         types.ObjectNull(CustomerShippingModel{}.Types()),
+				TaxExempt:        types.StringValue("none"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model CustomerResourceModel
+			var diags diag.Diagnostics
+
+			r := &CustomerResource{}
+			r.populateModel(context.Background(), &model, tt.customer, &diags)
+			require.False(t, diags.HasError(), diags)
+
+			assert.False(t, model.ConfigHash.IsNull())
+			tt.expected.ConfigHash = model.ConfigHash
+			assert.Equal(t, tt.expected, model)
+		})
+	}
+}
+
+func TestBuildCreateParamsCustomerResource(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     CustomerResourceModel
+		expected *stripe.CustomerParams
+	}{
+		{
+			name: "All fields filled",
+			plan: CustomerResourceModel{
+				Address:          testCustomerAddressModel(t, "1 Test Street", "Berlin", "DE"),
+				Description:      types.StringValue("A customer"),
+				Email:            types.StringValue("test@example.com"),
+				InvoicePrefix:    types.StringValue("ABC123"),
+				Metadata:         testMapValue(t, types.StringType, map[string]interface{}{"foo": "bar"}),
+				Name:             types.StringValue("Customer 1"),
+				Phone:            types.StringValue("+4930123456"),
+				PreferredLocales: testListValue(t, types.StringType, []string{"de", "en"}),
+				Shipping:         testCustomerShippingModel(t, "Recipient", testCustomerAddressModel(t, "2 Test Street", "Hamburg", "DE")),
+				TaxExempt:        types.StringValue("exempt"),
+			},
+			expected: &stripe.CustomerParams{
+				Address:          &stripe.AddressParams{Line1: stripe.String("1 Test Street"), City: stripe.String("Berlin"), Country: stripe.String("DE")},
+				Description:      stripe.String("A customer"),
+				Email:            stripe.String("test@example.com"),
+				InvoicePrefix:    stripe.String("ABC123"),
+				Name:             stripe.String("Customer 1"),
+				Phone:            stripe.String("+4930123456"),
+				PreferredLocales: []*string{stripe.String("de"), stripe.String("en")},
+				Shipping: &stripe.CustomerShippingParams{
+					Address: &stripe.AddressParams{Line1: stripe.String("2 Test Street"), City: stripe.String("Hamburg"), Country: stripe.String("DE")},
+					Name:    stripe.String("Recipient"),
+				},
+				TaxExempt: stripe.String("exempt"),
+			},
+		},
+		{
+			name: "Only required fields",
+			plan: CustomerResourceModel{
+				Address:          types.ObjectNull(CustomerAddressModel{}.Types()),
+				InvoicePrefix:    types.StringUnknown(),
+				Metadata:         types.MapNull(types.StringType),
+				PreferredLocales: types.ListNull(types.StringType),
+				Shipping:         types.ObjectNull(CustomerShippingModel{}.Types()),
+				TaxExempt:        types.StringValue("none"),
+			},
+			expected: &stripe.CustomerParams{
+				TaxExempt: stripe.String("none"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CustomerResource{}
+			diags := diag.Diagnostics{}
+			params := r.buildCreateParams(context.Background(), tt.plan, &diags)
+			require.False(t, diags.HasError())
+			if tt.plan.Metadata.IsNull() {
+				assert.Nil(t, params.Metadata)
+			} else {
+				assert.Equal(t, map[string]string{"foo": "bar"}, params.Metadata)
+				params.Metadata = nil
+			}
+			assert.Equal(t, tt.expected, params)
+		})
+	}
+}
+
+func TestBuildUpdateParamsCustomerResource(t *testing.T) {
+	address := testCustomerAddressModel(t, "1 Test Street", "Berlin", "DE")
+	shipping := testCustomerShippingModel(t, "Recipient", address)
+	tests := []struct {
+		name          string
+		state         CustomerResourceModel
+		plan          CustomerResourceModel
+		expected      *stripe.CustomerParams
+		expectedExtra url.Values
+	}{
+		{
+			name: "All fields updated",
+			state: CustomerResourceModel{
+				Address:          testCustomerAddressModel(t, "Old Street", "Hamburg", "DE"),
+				Description:      types.StringValue("Old customer"),
+				Email:            types.StringValue("old@example.com"),
+				InvoicePrefix:    types.StringValue("OLD123"),
+				Metadata:         testMapValue(t, types.StringType, map[string]interface{}{"key": "value"}),
+				Name:             types.StringValue("Old Name"),
+				Phone:            types.StringValue("+491"),
+				PreferredLocales: testListValue(t, types.StringType, []string{"en"}),
+				Shipping:         types.ObjectNull(CustomerShippingModel{}.Types()),
+				TaxExempt:        types.StringValue("none"),
+			},
+			plan: CustomerResourceModel{
+				Address:          address,
+				Description:      types.StringValue("New customer"),
+				Email:            types.StringValue("new@example.com"),
+				InvoicePrefix:    types.StringValue("NEW123"),
+				Metadata:         testMapValue(t, types.StringType, map[string]interface{}{"foo": "bar"}),
+				Name:             types.StringValue("New Name"),
+				Phone:            types.StringValue("+492"),
+				PreferredLocales: testListValue(t, types.StringType, []string{"de"}),
+				Shipping:         shipping,
+				TaxExempt:        types.StringValue("exempt"),
+			},
+			expected: &stripe.CustomerParams{
+				Address:          &stripe.AddressParams{Line1: stripe.String("1 Test Street"), City: stripe.String("Berlin"), Country: stripe.String("DE")},
+				Description:      stripe.String("New customer"),
+				Email:            stripe.String("new@example.com"),
+				InvoicePrefix:    stripe.String("NEW123"),
+				Metadata:         map[string]string{"key": "", "foo": "bar"},
+				Name:             stripe.String("New Name"),
+				Phone:            stripe.String("+492"),
+				PreferredLocales: []*string{stripe.String("de")},
+				Shipping: &stripe.CustomerShippingParams{
+					Address: &stripe.AddressParams{Line1: stripe.String("1 Test Street"), City: stripe.String("Berlin"), Country: stripe.String("DE")},
+					Name:    stripe.String("Recipient"),
+				},
+				TaxExempt: stripe.String("exempt"),
+			},
+		},
+		{
+			name: "All fields removed",
+			state: CustomerResourceModel{
+				Address:          address,
+				Description:      types.StringValue("Old customer"),
+				Email:            types.StringValue("old@example.com"),
+				InvoicePrefix:    types.StringValue("OLD123"),
+				Metadata:         testMapValue(t, types.StringType, map[string]interface{}{"key": "value"}),
+				Name:             types.StringValue("Old Name"),
+				Phone:            types.StringValue("+491"),
+				PreferredLocales: testListValue(t, types.StringType, []string{"en"}),
+				Shipping:         shipping,
+				TaxExempt:        types.StringValue("none"),
+			},
+			plan: CustomerResourceModel{
+				Address:          types.ObjectNull(CustomerAddressModel{}.Types()),
+				Description:      types.StringNull(),
+				Email:            types.StringNull(),
+				InvoicePrefix:    types.StringNull(),
+				Metadata:         types.MapNull(types.StringType),
+				Name:             types.StringNull(),
+				Phone:            types.StringNull(),
+				PreferredLocales: types.ListNull(types.StringType),
+				Shipping:         types.ObjectNull(CustomerShippingModel{}.Types()),
+				TaxExempt:        types.StringValue("none"),
+			},
+			expected: &stripe.CustomerParams{
+				Description:      stripe.String(""),
+				Email:            stripe.String(""),
+				Metadata:         map[string]string{"key": ""},
+				Name:             stripe.String(""),
+				Phone:            stripe.String(""),
+				PreferredLocales: []*string{},
+			},
+			expectedExtra: url.Values{"address": {""}, "shipping": {""}},
+		},
+		{
+			name: "No fields updated",
+			state: CustomerResourceModel{
+				Address:          address,
+				Metadata:         types.MapNull(types.StringType),
+				Name:             types.StringValue("Customer"),
+				PreferredLocales: types.ListNull(types.StringType),
+				Shipping:         shipping,
+				TaxExempt:        types.StringValue("none"),
+			},
+			plan: CustomerResourceModel{
+				Address:          address,
+				Metadata:         types.MapNull(types.StringType),
+				Name:             types.StringValue("Customer"),
+				PreferredLocales: types.ListNull(types.StringType),
+				Shipping:         shipping,
+				TaxExempt:        types.StringValue("none"),
+			},
+			expected: &stripe.CustomerParams{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CustomerResource{}
+			diags := diag.Diagnostics{}
+			params := r.buildUpdateParams(context.Background(), tt.state, tt.plan, &diags)
+			require.False(t, diags.HasError())
+			if tt.expectedExtra == nil {
+				assert.Nil(t, params.Extra)
+			} else {
+				require.NotNil(t, params.Extra)
+				assert.Equal(t, tt.expectedExtra, params.Extra.Values)
+				params.Extra = nil
+			}
+			assert.Equal(t, tt.expected, params)
+		})
+	}
+}
+
+func TestReadCustomerResourceRemoved(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		response string
+	}{
+		{"customer deleted", http.StatusOK, `{"id":"cus_123","object":"customer","deleted":true}`},
+		{"customer not found", http.StatusNotFound, `{"error":{"type":"invalid_request_error","code":"resource_missing","message":"No such customer"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &CustomerResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(tt.response))
+				}),
+			}
+			state := testState(t, r, map[string]interface{}{
+				"id":   types.StringValue("cus_123"),
+				"name": types.StringValue("Customer"),
+			})
+			resp := &fwresource.ReadResponse{State: state}
+			r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
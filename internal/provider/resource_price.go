@@ -1,31 +1,58 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stripe/stripe-go/v81"
 	"github.com/stripe/stripe-go/v81/client"
+
+	"github.com/zkoesters/terraform-provider-stripe/internal/provider/validator/customobjectvalidator"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PriceResource{}
+var _ resource.ResourceWithConfigValidators = &PriceResource{}
 var _ resource.ResourceWithImportState = &PriceResource{}
+var _ resource.ResourceWithModifyPlan = &PriceResource{}
+var _ resource.ResourceWithMoveState = &PriceResource{}
+var _ resource.ResourceWithUpgradeState = &PriceResource{}
+
+// decimalAmountRegexp matches a decimal amount in cents with at most 12
+// decimal places, as Stripe accepts for the decimal amounts of a price.
+var decimalAmountRegexp = regexp.MustCompile(`^\d+(\.\d{1,12})?$`)
 
 func NewPriceResource() resource.Resource {
 	return &PriceResource{}
@@ -33,7 +60,8 @@ func NewPriceResource() resource.Resource {
 
 // PriceResource defines the resource implementation.
 type PriceResource struct {
-	sc *client.API
+	sc           *client.API
+	providerData *StripeProviderData
 }
 
 // PriceResourceModel describes the resource data model.
@@ -41,14 +69,21 @@ type PriceResourceModel struct {
 	Id                types.String  `tfsdk:"id"`
 	Active            types.Bool    `tfsdk:"active"`
 	BillingScheme     types.String  `tfsdk:"billing_scheme"`
+	ConfigHash        types.String  `tfsdk:"config_hash"`
+	CreateIfMissing   types.Bool    `tfsdk:"create_if_missing"`
+	Created           types.Int64   `tfsdk:"created"`
 	Currency          types.String  `tfsdk:"currency"`
-	CurrencyOptions   types.Object  `tfsdk:"currency_options"`
+	CurrencyOptions   types.Map     `tfsdk:"currency_options"`
 	CustomUnitAmount  types.Object  `tfsdk:"custom_unit_amount"`
+	ExpandProduct     types.Bool    `tfsdk:"expand_product"`
 	LookupKey         types.String  `tfsdk:"lookup_key"`
 	Metadata          types.Map     `tfsdk:"metadata"`
+	MetadataKeys      types.List    `tfsdk:"metadata_keys"`
 	Nickname          types.String  `tfsdk:"nickname"`
 	Product           types.String  `tfsdk:"product"`
+	ProductDetails    types.Object  `tfsdk:"product_details"`
 	Recurring         types.Object  `tfsdk:"recurring"`
+	RequireLivemode   types.Bool    `tfsdk:"require_livemode"`
 	TaxBehavior       types.String  `tfsdk:"tax_behavior"`
 	Tiers             types.List    `tfsdk:"tiers"`
 	TiersMode         types.String  `tfsdk:"tiers_mode"`
@@ -63,6 +98,14 @@ type PriceCustomUnitAmount struct {
 	Preset  types.Int64 `tfsdk:"preset"`
 }
 
+func (m PriceCustomUnitAmount) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"maximum": types.Int64Type,
+		"minimum": types.Int64Type,
+		"preset":  types.Int64Type,
+	}
+}
+
 type PriceCurrencyOptions struct {
 	CustomUnitAmount  types.Object  `tfsdk:"custom_unit_amount"`
 	TaxBehavior       types.String  `tfsdk:"tax_behavior"`
@@ -72,19 +115,83 @@ type PriceCurrencyOptions struct {
 	TopLevel          types.Bool    `tfsdk:"top_level"`
 }
 
+func (m PriceCurrencyOptions) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"custom_unit_amount": types.ObjectType{
+			AttrTypes: PriceCustomUnitAmount{}.Types(),
+		},
+		"tax_behavior": types.StringType,
+		"tiers": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: PriceTierModel{}.Types(),
+			},
+		},
+		"unit_amount":         types.Int64Type,
+		"unit_amount_decimal": types.Float64Type,
+		"top_level":           types.BoolType,
+	}
+}
+
+type PriceTierModel struct {
+	FlatAmount        types.Int64  `tfsdk:"flat_amount"`
+	FlatAmountDecimal types.String `tfsdk:"flat_amount_decimal"`
+	UnitAmount        types.Int64  `tfsdk:"unit_amount"`
+	UnitAmountDecimal types.String `tfsdk:"unit_amount_decimal"`
+	UpTo              types.Int64  `tfsdk:"up_to"`
+}
+
+func (m PriceTierModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"flat_amount":         types.Int64Type,
+		"flat_amount_decimal": types.StringType,
+		"unit_amount":         types.Int64Type,
+		"unit_amount_decimal": types.StringType,
+		"up_to":               types.Int64Type,
+	}
+}
+
+type PriceProductDetails struct {
+	Active types.Bool   `tfsdk:"active"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func (m PriceProductDetails) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"active": types.BoolType,
+		"name":   types.StringType,
+	}
+}
+
 type PriceRecurring struct {
 	Interval       types.String `tfsdk:"interval"`
 	AggregateUsage types.String `tfsdk:"aggregate_usage"`
-	IntervalCount  types.String `tfsdk:"interval_count"`
+	IntervalCount  types.Int64  `tfsdk:"interval_count"`
 	Meter          types.String `tfsdk:"meter"`
 	UsageType      types.String `tfsdk:"usage_type"`
 }
 
+func (m PriceRecurring) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interval":        types.StringType,
+		"aggregate_usage": types.StringType,
+		"interval_count":  types.Int64Type,
+		"meter":           types.StringType,
+		"usage_type":      types.StringType,
+	}
+}
+
 type PriceTransformQuantity struct {
 	DivideBy types.Int64  `tfsdk:"divide_by"`
 	Round    types.String `tfsdk:"round"`
 }
 
+func (m PriceTransformQuantity) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"divide_by": types.Int64Type,
+		"round":     types.StringType,
+	}
+}
+
 func (r *PriceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_price"
 }
@@ -137,6 +244,7 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					MarkdownDescription: "Same as `flat_amount`, but contains a decimal value with at most 12 decimal places.",
 					Optional:            true,
 					Validators: []validator.String{
+						stringvalidator.RegexMatches(decimalAmountRegexp, "must be a decimal amount with at most 12 decimal places"),
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("flat_amount")),
 					},
 				},
@@ -151,17 +259,21 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					MarkdownDescription: "Same as `unit_amount`, but contains a decimal value with at most 12 decimal places.",
 					Optional:            true,
 					Validators: []validator.String{
+						stringvalidator.RegexMatches(decimalAmountRegexp, "must be a decimal amount with at most 12 decimal places"),
 						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("unit_amount")),
 					},
 				},
 				"up_to": schema.Int64Attribute{
-					MarkdownDescription: "Up to and including to this quantity will be contained in the tier.",
-					Required:            true,
+					MarkdownDescription: "Up to and including to this quantity will be contained in the tier. Leave unset on the final tier to have it cover all remaining quantities.",
+					Optional:            true,
 					Validators: []validator.Int64{
 						int64validator.AtLeast(0),
 					},
 				},
 			},
+			Validators: []validator.Object{
+				customobjectvalidator.AtLeastOneAttribute("flat_amount", "flat_amount_decimal", "unit_amount", "unit_amount_decimal"),
+			},
 		},
 	}
 	unitAmountAttribute := schema.Int64Attribute{
@@ -183,6 +295,7 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "A webhook endpoint resource",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -205,24 +318,58 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 				Optional:            true,
 				Default:             stringdefault.StaticString("per_unit"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("per_unit", "tiered"),
 				},
 			},
+			"config_hash": configHashAttribute(),
+			"create_if_missing": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, an existing price with the same `lookup_key` is adopted on create instead of creating a new one. Its `active`, `currency_options`, `lookup_key`, `metadata`, `nickname` and `tax_behavior` are updated to match the configuration; any other difference is an error, as prices cannot be changed otherwise.",
+				Optional:            true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("lookup_key")),
+				},
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Time at which the object was created. Measured in seconds since the Unix epoch.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"currency": schema.StringAttribute{
-				MarkdownDescription: "Three-letter ISO currency code, in lowercase. Must be a supported currency.",
-				Required:            true,
+				MarkdownDescription: "Three-letter ISO currency code, in lowercase. Must be a supported currency. Computed from the `top_level` entry when `currency_options` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 				Validators: []validator.String{
-					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("currency_options")),
+					stringvalidator.RegexMatches(lowercaseCurrencyRegexp, "must be a lowercase three-letter ISO currency code"),
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("currency_options")),
 				},
 			},
 			"currency_options": schema.MapNestedAttribute{
 				MarkdownDescription: "Prices defined in each available currency option.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"custom_unit_amount":  customUnitAmountAttribute,
-						"tax_behavior":        taxBehaviorAttribute,
-						"tiers":               tiersAttribute,
+						"custom_unit_amount": customUnitAmountAttribute,
+						"tax_behavior": schema.StringAttribute{
+							MarkdownDescription: taxBehaviorAttribute.MarkdownDescription + " Defaults to the provider's `default_tax_behavior`, or `unspecified`, for new currency options.",
+							Optional:            true,
+							Computed:            true,
+							Default:             taxBehaviorAttribute.Default,
+							Validators:          taxBehaviorAttribute.Validators,
+						},
+						"tiers": schema.ListNestedAttribute{
+							MarkdownDescription: tiersAttribute.MarkdownDescription + " Not allowed on the `top_level` entry, whose tiers are set by the top-level `tiers`.",
+							Optional:            true,
+							NestedObject:        tiersAttribute.NestedObject,
+						},
 						"unit_amount":         unitAmountAttribute,
 						"unit_amount_decimal": unitAmountDecimalAttribute,
 						"top_level": schema.BoolAttribute{
@@ -234,28 +381,72 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 				Optional: true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, request planmodifier.MapRequest, response *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+							if request.StateValue.IsNull() || request.PlanValue.Equal(request.StateValue) {
+								return
+							}
+							planCurrencyOptions := map[string]PriceCurrencyOptions{}
+							stateCurrencyOptions := map[string]PriceCurrencyOptions{}
+							configCurrencyOptions := map[string]PriceCurrencyOptions{}
+							request.PlanValue.ElementsAs(ctx, &planCurrencyOptions, false)
+							request.StateValue.ElementsAs(ctx, &stateCurrencyOptions, false)
+							if !request.ConfigValue.IsNull() && !request.ConfigValue.IsUnknown() {
+								request.ConfigValue.ElementsAs(ctx, &configCurrencyOptions, false)
+							}
+							for k, v := range stateCurrencyOptions {
+								planValue, exists := planCurrencyOptions[k]
+								if !exists {
+									response.RequiresReplace = true
+									continue
+								}
+								if v.TopLevel.ValueBool() || planValue.TopLevel.ValueBool() {
+									// An unset tax behavior keeps the state value, see
+									// ModifyPlan.
+									if configValue, ok := configCurrencyOptions[k]; ok && configValue.TaxBehavior.IsNull() {
+										planValue.TaxBehavior = v.TaxBehavior
+									}
+									planElement, diags := types.ObjectValueFrom(ctx, PriceCurrencyOptions{}.Types(), planValue)
+									if diags.HasError() || !planElement.Equal(request.StateValue.Elements()[k]) {
+										response.RequiresReplace = true
+									}
+								}
+							}
+						},
+						"If the top-level entry changes or elements are removed, Terraform will destroy and recreate the resource.",
+						"If the top-level entry changes or elements are removed, Terraform will destroy and recreate the resource.",
+					),
+				},
 				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(lowercaseCurrencyRegexp, "must be a lowercase three-letter ISO currency code")),
 					mapvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("currency")),
 					mapvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("unit_amount")),
 					mapvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("unit_amount_decimal")),
 				},
 			},
-			"lookup_key": schema.StringAttribute{
-				MarkdownDescription: "A lookup key used to retrieve prices dynamically from a static string.",
+			"custom_unit_amount": schema.SingleNestedAttribute{
+				MarkdownDescription: customUnitAmountAttribute.MarkdownDescription + " Computed from the `top_level` entry when `currency_options` is set.",
 				Optional:            true,
+				Computed:            true,
+				Attributes:          customUnitAmountAttribute.Attributes,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+					objectplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: customUnitAmountAttribute.Validators,
 			},
-			"metadata": schema.MapAttribute{
-				MarkdownDescription: "Set of key-value pairs that you can attach to an object. ",
-				ElementType:         types.StringType,
+			"expand_product": schema.BoolAttribute{
+				MarkdownDescription: "Whether to read the product of the price along with it, to fill `product_details`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"lookup_key": schema.StringAttribute{
+				MarkdownDescription: "A lookup key used to retrieve prices dynamically from a static string. Prices can be imported by their lookup key as well as by their ID.",
 				Optional:            true,
-				Validators: []validator.Map{
-					mapvalidator.SizeAtMost(50),
-					mapvalidator.KeysAre(
-						stringvalidator.LengthAtMost(40)),
-					mapvalidator.ValueStringsAre(
-						stringvalidator.LengthAtMost(500)),
-				},
 			},
+			"metadata":      metadataAttribute(),
+			"metadata_keys": metadataKeysAttribute(),
 			"nickname": schema.StringAttribute{
 				MarkdownDescription: "A brief description of the price, hidden from customers.",
 				Optional:            true,
@@ -263,10 +454,33 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			"product": schema.StringAttribute{
 				MarkdownDescription: "The ID of the product that this price will belong to.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_details": schema.SingleNestedAttribute{
+				MarkdownDescription: "Details of the product that this price belongs to. Only set when `expand_product` is `true`.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"active": schema.BoolAttribute{
+						MarkdownDescription: "Whether the product is currently available for purchase.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The product's name, meant to be displayable to the customer.",
+						Computed:            true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"recurring": schema.SingleNestedAttribute{
 				MarkdownDescription: "The recurring components of a price such as `interval` and `usage_type`.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"interval": schema.StringAttribute{
 						MarkdownDescription: "Specifies billing frequency. Either `day`, `week`, `month` or `year`.",
@@ -284,9 +498,14 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							stringvalidator.OneOf("last_during_period", "last_ever", "max", "sum"),
 						},
 					},
-					"interval_count": schema.StringAttribute{
-						MarkdownDescription: "The number of intervals (specified in the `interval` attribute) between subscription billings.",
+					"interval_count": schema.Int64Attribute{
+						MarkdownDescription: "The number of intervals (specified in the `interval` attribute) between subscription billings. Defaults to `1`.",
+						Computed:            true,
 						Optional:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
 					},
 					"meter": schema.StringAttribute{
 						MarkdownDescription: "The meter tracking the usage of a metered price.",
@@ -303,9 +522,30 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"require_livemode": requireLivemodeAttribute(),
+			"tax_behavior": schema.StringAttribute{
+				MarkdownDescription: taxBehaviorAttribute.MarkdownDescription + " Defaults to the provider's `default_tax_behavior`, or `unspecified`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: taxBehaviorAttribute.Validators,
+			},
+			"tiers": schema.ListNestedAttribute{
+				MarkdownDescription: tiersAttribute.MarkdownDescription,
+				Optional:            true,
+				NestedObject:        tiersAttribute.NestedObject,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
 			"tiers_mode": schema.StringAttribute{
 				MarkdownDescription: "Defines if the tiering price should be `graduated` or `volume` based. In `volume`-based tiering, the maximum quantity within a period determines the per unit price. In `graduated` tiering, pricing can change as the quantity grows.",
 				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.String{
 					stringvalidator.OneOf("graduated", "volume"),
 				},
@@ -323,32 +563,398 @@ func (r *PriceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						Required:            true,
 					},
 				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
 				Validators: []validator.Object{
 					objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("tiers")),
 				},
 			},
+			"unit_amount": schema.Int64Attribute{
+				MarkdownDescription: unitAmountAttribute.MarkdownDescription + " Computed from the `top_level` entry when `currency_options` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: unitAmountAttribute.Validators,
+			},
+			"unit_amount_decimal": schema.Float64Attribute{
+				MarkdownDescription: unitAmountDecimalAttribute.MarkdownDescription + " Computed from the `top_level` entry when `currency_options` is set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+					float64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: unitAmountDecimalAttribute.Validators,
+			},
 		},
 	}
 }
 
+func (r *PriceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		priceTiersValidator{},
+		priceTopLevelValidator{},
+	}
+}
+
+// priceTiersValidator requires tiers and tiers_mode to be set if, and only if,
+// the billing scheme of a price is tiered, and only the final tier to leave
+// up_to unset. The tiers of currency options follow the same rules.
+type priceTiersValidator struct{}
+
+func (v priceTiersValidator) Description(_ context.Context) string {
+	return "tiers and tiers_mode must be set if and only if billing_scheme is tiered, and only the final tier may leave up_to unset"
+}
+
+func (v priceTiersValidator) MarkdownDescription(_ context.Context) string {
+	return "`tiers` and `tiers_mode` must be set if and only if `billing_scheme` is `tiered`, and only the final tier may leave `up_to` unset"
+}
+
+func (v priceTiersValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var billingScheme, tiersMode types.String
+	var tiers types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("billing_scheme"), &billingScheme)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tiers_mode"), &tiersMode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tiers"), &tiers)...)
+	if resp.Diagnostics.HasError() || billingScheme.IsUnknown() {
+		return
+	}
+
+	// A null billing scheme takes the default of per_unit.
+	tiered := billingScheme.ValueString() == "tiered"
+	if tiered && tiers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tiers"),
+			"Missing Attribute Configuration",
+			"tiers must be set when billing_scheme is tiered.",
+		)
+	}
+	if tiered && tiersMode.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tiers_mode"),
+			"Missing Attribute Configuration",
+			"tiers_mode must be set when billing_scheme is tiered.",
+		)
+	}
+	if !tiered && !tiers.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tiers"),
+			"Invalid Attribute Combination",
+			"tiers can only be set when billing_scheme is tiered.",
+		)
+	}
+	if !tiered && !tiersMode.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tiers_mode"),
+			"Invalid Attribute Combination",
+			"tiers_mode can only be set when billing_scheme is tiered.",
+		)
+	}
+
+	validatePriceTiersUpTo(tiers, path.Root("tiers"), &resp.Diagnostics)
+
+	// Currency options other than the top-level one are tiered by their own
+	// tiers, the top-level currency is tiered by tiers.
+	var currencyOptions types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currency_options"), &currencyOptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for currency, element := range currencyOptions.Elements() {
+		option, ok := element.(types.Object)
+		if !ok || option.IsUnknown() || option.IsNull() {
+			continue
+		}
+		optionTiers, ok := option.Attributes()["tiers"].(types.List)
+		if !ok || optionTiers.IsNull() {
+			continue
+		}
+		optionPath := path.Root("currency_options").AtMapKey(currency).AtName("tiers")
+		if topLevel, ok := option.Attributes()["top_level"].(types.Bool); ok && topLevel.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				optionPath,
+				"Invalid Attribute Combination",
+				"The tiers of the top_level entry of currency_options are set by tiers.",
+			)
+			continue
+		}
+		if !tiered {
+			resp.Diagnostics.AddAttributeError(
+				optionPath,
+				"Invalid Attribute Combination",
+				"tiers can only be set when billing_scheme is tiered.",
+			)
+			continue
+		}
+		validatePriceTiersUpTo(optionTiers, optionPath, &resp.Diagnostics)
+	}
+}
+
+// validatePriceTiersUpTo requires up_to to be set on every tier but the final
+// one, which covers all remaining quantities.
+func validatePriceTiersUpTo(tiers types.List, tiersPath path.Path, respDiag *diag.Diagnostics) {
+	if tiers.IsNull() || tiers.IsUnknown() {
+		return
+	}
+	elements := tiers.Elements()
+	for i, element := range elements {
+		tier, ok := element.(types.Object)
+		if !ok || tier.IsUnknown() {
+			continue
+		}
+		upTo, ok := tier.Attributes()["up_to"].(types.Int64)
+		if !ok || upTo.IsUnknown() {
+			continue
+		}
+		final := i == len(elements)-1
+		if final && !upTo.IsNull() {
+			respDiag.AddAttributeError(
+				tiersPath.AtListIndex(i).AtName("up_to"),
+				"Invalid Attribute Configuration",
+				"The final tier must leave up_to unset, so that it covers all remaining quantities.",
+			)
+		}
+		if !final && upTo.IsNull() {
+			respDiag.AddAttributeError(
+				tiersPath.AtListIndex(i).AtName("up_to"),
+				"Missing Attribute Configuration",
+				"up_to must be set on every tier but the final one.",
+			)
+		}
+	}
+}
+
+// priceTopLevelValidator requires exactly one currency option of a price to be
+// the top-level one, which gives the currency and unit amount of the price
+// itself. Without it, the price would be created without a currency.
+type priceTopLevelValidator struct{}
+
+func (v priceTopLevelValidator) Description(_ context.Context) string {
+	return "exactly one entry of currency_options must set top_level"
+}
+
+func (v priceTopLevelValidator) MarkdownDescription(_ context.Context) string {
+	return "exactly one entry of `currency_options` must set `top_level`"
+}
+
+func (v priceTopLevelValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var currencyOptions types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currency_options"), &currencyOptions)...)
+	if resp.Diagnostics.HasError() || currencyOptions.IsNull() || currencyOptions.IsUnknown() {
+		return
+	}
+
+	var topLevel []string
+	for currency, element := range currencyOptions.Elements() {
+		option, ok := element.(types.Object)
+		if !ok || option.IsUnknown() {
+			return
+		}
+		value, ok := option.Attributes()["top_level"].(types.Bool)
+		if !ok || value.IsUnknown() {
+			return
+		}
+		if value.ValueBool() {
+			topLevel = append(topLevel, currency)
+		}
+	}
+	if len(topLevel) == 1 {
+		return
+	}
+
+	slices.Sort(topLevel)
+	detail := "No entry of currency_options sets top_level."
+	if len(topLevel) > 1 {
+		detail = fmt.Sprintf("The entries %s of currency_options all set top_level.", strings.Join(topLevel, ", "))
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("currency_options"),
+		"Invalid Attribute Combination",
+		"Exactly one entry of currency_options must set top_level, which gives the currency and unit amount of the price itself. "+detail,
+	)
+}
+
 func (r *PriceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	sc, ok := req.ProviderData.(*client.API)
+	providerData, ok := req.ProviderData.(*StripeProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sc = sc
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *PriceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if r.providerData != nil && r.providerData.ValidateAgainstAPI {
+		resp.Diagnostics.Append(r.validateCurrencies(ctx, req)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var expandProduct types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expand_product"), &expandProduct)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !expandProduct.ValueBool() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("product_details"), types.ObjectNull(PriceProductDetails{}.Types()))...)
+	}
+
+	r.planCurrencyOptionTaxBehaviors(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only new prices take the default, existing prices keep their tax behavior.
+	if !req.State.Raw.IsNull() {
+		return
+	}
+
+	var taxBehavior types.String
+	var currencyOptions types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tax_behavior"), &taxBehavior)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("currency_options"), &currencyOptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A configured tax behavior wins, and with currency options the tax
+	// behavior comes from the top-level entry.
+	if !taxBehavior.IsUnknown() || !currencyOptions.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tax_behavior"), types.StringValue(r.defaultTaxBehavior()))...)
+}
+
+// defaultTaxBehavior returns the tax behavior of new prices that do not
+// configure one: the provider's default_tax_behavior, or unspecified.
+func (r *PriceResource) defaultTaxBehavior() string {
+	if r.providerData != nil && r.providerData.DefaultTaxBehavior != "" {
+		return r.providerData.DefaultTaxBehavior
+	}
+	return "unspecified"
+}
+
+// planCurrencyOptionTaxBehaviors plans the tax behavior of the currency
+// options that do not configure one. Like the top-level tax_behavior,
+// currency options of an existing price keep their tax behavior, and new ones
+// take the default.
+func (r *PriceResource) planCurrencyOptionTaxBehaviors(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var configCurrencyOptions, stateCurrencyOptions types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currency_options"), &configCurrencyOptions)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("currency_options"), &stateCurrencyOptions)...)
+	}
+	if resp.Diagnostics.HasError() || configCurrencyOptions.IsNull() || configCurrencyOptions.IsUnknown() {
+		return
+	}
+
+	configOptions := map[string]PriceCurrencyOptions{}
+	stateOptions := map[string]PriceCurrencyOptions{}
+	resp.Diagnostics.Append(configCurrencyOptions.ElementsAs(ctx, &configOptions, false)...)
+	if !stateCurrencyOptions.IsNull() && !stateCurrencyOptions.IsUnknown() {
+		resp.Diagnostics.Append(stateCurrencyOptions.ElementsAs(ctx, &stateOptions, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for currency, option := range configOptions {
+		if !option.TaxBehavior.IsNull() {
+			continue
+		}
+		taxBehavior := types.StringValue(r.defaultTaxBehavior())
+		if stateOption, ok := stateOptions[currency]; ok && !stateOption.TaxBehavior.IsNull() {
+			taxBehavior = stateOption.TaxBehavior
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("currency_options").AtMapKey(currency).AtName("tax_behavior"), taxBehavior)...)
+	}
+}
+
+// validateCurrencies returns an error diagnostic for each new currency of the
+// price that the account does not support. Other API errors only produce a
+// warning, as they do not tell whether the currencies are valid.
+func (r *PriceResource) validateCurrencies(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planCurrency, stateCurrency types.String
+	var planCurrencyOptions, stateCurrencyOptions types.Map
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("currency"), &planCurrency)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("currency_options"), &planCurrencyOptions)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("currency"), &stateCurrency)...)
+		diags.Append(req.State.GetAttribute(ctx, path.Root("currency_options"), &stateCurrencyOptions)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	stateCurrencies := priceCurrencies(stateCurrency, stateCurrencyOptions)
+	var newCurrencies []string
+	for _, currency := range priceCurrencies(planCurrency, planCurrencyOptions) {
+		if !slices.Contains(stateCurrencies, currency) {
+			newCurrencies = append(newCurrencies, currency)
+		}
+	}
+	if len(newCurrencies) == 0 {
+		return diags
+	}
+
+	supported, err := r.providerData.SupportedCurrencies()
+	if err != nil {
+		diags.AddWarning("Client Error", fmt.Sprintf("Unable to validate currencies, got error: %s", formatStripeError(err)))
+		return diags
+	}
+
+	for _, currency := range newCurrencies {
+		if !slices.Contains(supported, stripe.Currency(currency)) {
+			diags.AddAttributeError(
+				path.Root("currency"),
+				"Unsupported currency",
+				fmt.Sprintf("The currency %s is not supported by the Stripe account.", currency),
+			)
+		}
+	}
+	return diags
+}
+
+// priceCurrencies returns the known currencies of a price, being its currency
+// and the keys of its currency options, in sorted order.
+func priceCurrencies(currency types.String, currencyOptions types.Map) []string {
+	var currencies []string
+	if !currency.IsNull() && !currency.IsUnknown() {
+		currencies = append(currencies, currency.ValueString())
+	}
+	if !currencyOptions.IsNull() && !currencyOptions.IsUnknown() {
+		for key := range currencyOptions.Elements() {
+			if !slices.Contains(currencies, key) {
+				currencies = append(currencies, key)
+			}
+		}
+	}
+	slices.Sort(currencies)
+	return currencies
 }
 
 func (r *PriceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -363,20 +969,61 @@ func (r *PriceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	params := r.buildCreateParams(plan)
+	params := r.buildCreateParams(ctx, plan, &resp.Diagnostics)
 
-	price, err = r.sc.Prices.New(params)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create price, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	params.AddExpand("currency_options")
+	params.AddExpand("tiers")
+	for _, expand := range priceCurrencyOptionExpands(plan.CurrencyOptions) {
+		params.AddExpand(expand)
+	}
+	if plan.ExpandProduct.ValueBool() {
+		params.AddExpand("product")
+	}
+
+	if plan.CreateIfMissing.ValueBool() {
+		price, err = r.findPriceByLookupKey(plan.LookupKey.ValueString(), plan.ExpandProduct.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up price, got error: %s", formatStripeError(err)))
+			return
+		}
+		if price != nil {
+			tflog.Info(ctx, "Adopting existing price", map[string]interface{}{
+				"id":         price.ID,
+				"lookup_key": price.LookupKey,
+			})
+			price = r.adoptPrice(ctx, plan, price, params.Expand, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if price == nil {
+		price, err = r.sc.Prices.New(params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create price, got error: %s", formatStripeError(err)))
+			return
+		}
+	}
 
 	plan.Id = types.StringValue(price.ID)
-	r.populateModel(&plan, price)
+	var populateDiags diag.Diagnostics
+	r.populateModel(ctx, &plan, price, &populateDiags)
+	resp.Diagnostics.Append(populateDiags...)
+	if populateDiags.HasError() {
+		// Keep track of the created price so that it is not leaked. The
+		// error taints it, so it is replaced on the next apply.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+		return
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -398,13 +1045,27 @@ func (r *PriceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	price, err = r.sc.Prices.Get(state.Id.ValueString(), nil)
+	params := &stripe.PriceParams{}
+	params.AddExpand("currency_options")
+	params.AddExpand("tiers")
+	for _, expand := range priceCurrencyOptionExpands(state.CurrencyOptions) {
+		params.AddExpand(expand)
+	}
+	if state.ExpandProduct.ValueBool() {
+		params.AddExpand("product")
+	}
+	price, err = r.sc.Prices.Get(state.Id.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read price, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read price, got error: %s", formatStripeError(err)))
 		return
 	}
 
-	r.populateModel(&state, price)
+	resp.Diagnostics.Append(checkStripeObject(price.Object, "price", price.ID, "price_")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.populateModel(ctx, &state, price, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -427,14 +1088,25 @@ func (r *PriceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	params := r.buildUpdateParams(state, plan)
+	params := r.buildUpdateParams(ctx, state, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.AddExpand("currency_options")
+	params.AddExpand("tiers")
+	for _, expand := range priceCurrencyOptionExpands(plan.CurrencyOptions) {
+		params.AddExpand(expand)
+	}
+	if plan.ExpandProduct.ValueBool() {
+		params.AddExpand("product")
+	}
 
 	price, err = r.sc.Prices.Update(plan.Id.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create price, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update price, got error: %s", formatStripeError(err)))
 		return
 	}
-	r.populateModel(&plan, price)
+	r.populateModel(ctx, &plan, price, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -462,39 +1134,795 @@ func (r *PriceResource) ImportState(ctx context.Context, req resource.ImportStat
 	var price *stripe.Price
 	var err error
 
-	price, err = r.sc.Prices.Get(req.ID, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import price, got error: %s", err))
+	// Prices are imported by ID, or otherwise by lookup key, which may be
+	// any string that is not the ID of another type of object.
+	resp.Diagnostics.Append(checkImportID(req.ID, "price", "price_", true)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	state.Id = types.StringValue(req.ID)
-	r.populateModel(&state, price)
+	if strings.HasPrefix(req.ID, "price_") {
+		params := &stripe.PriceParams{}
+		params.AddExpand("currency_options")
+		params.AddExpand("tiers")
+		price, err = r.sc.Prices.Get(req.ID, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import price, got error: %s", formatStripeError(err)))
+			return
+		}
+	} else {
+		price, err = r.findPriceByLookupKey(req.ID, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up price, got error: %s", formatStripeError(err)))
+			return
+		}
+		if price == nil {
+			resp.Diagnostics.AddError(
+				"Price Not Found",
+				fmt.Sprintf("No price has the lookup key %q. Import a price by its ID, which starts with \"price_\", or by its lookup_key. A nickname cannot be used, as nicknames are not unique.", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(price.Object, "price", price.ID, "price_")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The tiers of currency options are only returned when expanded by
+	// currency, which is only known now.
+	if price.BillingScheme == stripe.PriceBillingSchemeTiered && len(price.CurrencyOptions) > 1 {
+		params := &stripe.PriceParams{}
+		params.AddExpand("currency_options")
+		params.AddExpand("tiers")
+		for currency := range price.CurrencyOptions {
+			if currency != string(price.Currency) {
+				params.AddExpand("currency_options." + currency + ".tiers")
+			}
+		}
+		price, err = r.sc.Prices.Get(price.ID, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import price, got error: %s", formatStripeError(err)))
+			return
+		}
+	}
+
+	state.Id = types.StringValue(price.ID)
+	r.populateModel(ctx, &state, price, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *PriceResource) populateModel(model *PriceResourceModel, price *stripe.Price) {
+func (r *PriceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateMovers("stripe_price", func(ctx context.Context, attrs map[string]any, resp *resource.MoveStateResponse) {
+		state := PriceResourceModel{
+			Id:              movedString(attrs, "id"),
+			Active:          movedBool(attrs, "active"),
+			BillingScheme:   movedString(attrs, "billing_scheme"),
+			CreateIfMissing: types.BoolNull(),
+			Created:         movedInt64(attrs, "created"),
+			Currency:        movedString(attrs, "currency"),
+			CurrencyOptions: types.MapNull(types.ObjectType{
+				AttrTypes: PriceCurrencyOptions{}.Types(),
+			}),
+			CustomUnitAmount: types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			ExpandProduct:    types.BoolNull(),
+			LookupKey:        movedString(attrs, "lookup_key"),
+			Nickname:         movedString(attrs, "nickname"),
+			Product:          movedString(attrs, "product"),
+			ProductDetails:   types.ObjectNull(PriceProductDetails{}.Types()),
+			Recurring:        types.ObjectNull(PriceRecurring{}.Types()),
+			TaxBehavior:      movedString(attrs, "tax_behavior"),
+			Tiers: types.ListNull(types.ObjectType{
+				AttrTypes: PriceTierModel{}.Types(),
+			}),
+			TiersMode:         movedString(attrs, "tiers_mode"),
+			TransformQuantity: types.ObjectNull(PriceTransformQuantity{}.Types()),
+			UnitAmount:        movedInt64(attrs, "unit_amount"),
+			UnitAmountDecimal: movedFloat64(attrs, "unit_amount_decimal"),
+		}
+
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, movedStringMap(attrs, "metadata"))
+		resp.Diagnostics.Append(diags...)
+		state.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+		state.MetadataKeys = metadataKeys(state.Metadata)
+
+		if recurring := movedObject(attrs, "recurring"); recurring != nil {
+			pr := PriceRecurring{
+				Interval:       movedString(recurring, "interval"),
+				AggregateUsage: movedString(recurring, "aggregate_usage"),
+				IntervalCount:  movedInt64(recurring, "interval_count"),
+				Meter:          movedString(recurring, "meter"),
+				UsageType:      movedString(recurring, "usage_type"),
+			}
+			// Match the schema defaults so the move does not plan a change.
+			if pr.AggregateUsage.IsNull() {
+				pr.AggregateUsage = types.StringValue("sum")
+			}
+			if pr.UsageType.IsNull() {
+				pr.UsageType = types.StringValue("licensed")
+			}
+			o, diags := types.ObjectValueFrom(ctx, PriceRecurring{}.Types(), pr)
+			resp.Diagnostics.Append(diags...)
+			state.Recurring = o
+		}
+		if transformQuantity := movedObject(attrs, "transform_quantity"); transformQuantity != nil {
+			o, diags := types.ObjectValueFrom(ctx, PriceTransformQuantity{}.Types(), PriceTransformQuantity{
+				DivideBy: movedInt64(transformQuantity, "divide_by"),
+				Round:    movedString(transformQuantity, "round"),
+			})
+			resp.Diagnostics.Append(diags...)
+			state.TransformQuantity = o
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	})
+}
+
+func (r *PriceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored recurring.interval_count as a string.
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					return
+				}
+
+				attrs := map[string]any{}
+				decoder := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				decoder.UseNumber()
+				if err := decoder.Decode(&attrs); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The price state could not be decoded: %s", err))
+					return
+				}
+
+				if recurring, ok := attrs["recurring"].(map[string]any); ok {
+					// An unset interval count is the default of 1.
+					intervalCount, _ := recurring["interval_count"].(string)
+					recurring["interval_count"] = 1
+					if intervalCount != "" {
+						count, err := strconv.ParseInt(intervalCount, 10, 64)
+						if err != nil {
+							resp.Diagnostics.AddAttributeError(
+								path.Root("recurring").AtName("interval_count"),
+								"Unable to Upgrade Resource State",
+								fmt.Sprintf("The interval count %q of the price is not an integer.", intervalCount),
+							)
+							return
+						}
+						recurring["interval_count"] = count
+					}
+				}
+
+				upgraded, err := json.Marshal(attrs)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("The price state could not be encoded: %s", err))
+					return
+				}
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// adoptPrice brings an existing price found by its lookup key in line with
+// the plan. The fields that Stripe allows to be updated are updated, a
+// mismatch in any other field is reported as an error, as the price would
+// have to be replaced.
+func (r *PriceResource) adoptPrice(ctx context.Context, plan PriceResourceModel, price *stripe.Price, expand []*string, respDiag *diag.Diagnostics) *stripe.Price {
+	adopted := plan
+	r.populateModel(ctx, &adopted, price, respDiag)
+	if respDiag.HasError() {
+		return nil
+	}
+
+	if mismatches := priceAdoptionMismatches(plan, adopted); len(mismatches) > 0 {
+		respDiag.AddError(
+			"Existing Price Does Not Match",
+			fmt.Sprintf("The price %s with the lookup key %q differs from the configuration in %s, which cannot be updated on an existing price. "+
+				"Change the configuration to match the existing price, or remove the lookup key from it to create a new price.",
+				price.ID, price.LookupKey, strings.Join(mismatches, ", ")),
+		)
+		return nil
+	}
+
+	params := r.buildUpdateParams(ctx, adopted, plan, respDiag)
+	if respDiag.HasError() {
+		return nil
+	}
+	params.Expand = expand
+	price, err := r.sc.Prices.Update(price.ID, params)
+	if err != nil {
+		respDiag.AddError("Client Error", fmt.Sprintf("Unable to update adopted price, got error: %s", formatStripeError(err)))
+		return nil
+	}
+	return price
+}
+
+// priceAdoptionMismatches returns the attributes of an adopted price that
+// differ from the plan and cannot be updated. Attributes that are unknown in
+// the plan are computed and match any value.
+func priceAdoptionMismatches(plan, adopted PriceResourceModel) []string {
+	immutable := []struct {
+		name          string
+		plan, adopted attr.Value
+	}{
+		{"billing_scheme", plan.BillingScheme, adopted.BillingScheme},
+		{"currency", plan.Currency, adopted.Currency},
+		{"custom_unit_amount", plan.CustomUnitAmount, adopted.CustomUnitAmount},
+		{"product", plan.Product, adopted.Product},
+		{"recurring", plan.Recurring, adopted.Recurring},
+		{"tiers", plan.Tiers, adopted.Tiers},
+		{"tiers_mode", plan.TiersMode, adopted.TiersMode},
+		{"transform_quantity", plan.TransformQuantity, adopted.TransformQuantity},
+		{"unit_amount", plan.UnitAmount, adopted.UnitAmount},
+		{"unit_amount_decimal", plan.UnitAmountDecimal, adopted.UnitAmountDecimal},
+	}
+	var mismatches []string
+	for _, a := range immutable {
+		if !a.plan.IsUnknown() && !a.plan.Equal(a.adopted) {
+			mismatches = append(mismatches, a.name)
+		}
+	}
+
+	// Currency options can be added and changed, but not removed, and the
+	// amount of the top-level option is the amount of the price itself.
+	planOptions := plan.CurrencyOptions.Elements()
+	for currency, adoptedOption := range adopted.CurrencyOptions.Elements() {
+		planOption, ok := planOptions[currency]
+		if !ok {
+			mismatches = append(mismatches, "currency_options")
+			break
+		}
+		adoptedAttributes := adoptedOption.(types.Object).Attributes()
+		planAttributes := planOption.(types.Object).Attributes()
+		if !adoptedAttributes["top_level"].Equal(types.BoolValue(true)) {
+			continue
+		}
+		if !planAttributes["top_level"].Equal(types.BoolValue(true)) ||
+			!planAttributes["custom_unit_amount"].Equal(adoptedAttributes["custom_unit_amount"]) ||
+			!planAttributes["unit_amount"].Equal(adoptedAttributes["unit_amount"]) ||
+			!planAttributes["unit_amount_decimal"].Equal(adoptedAttributes["unit_amount_decimal"]) {
+			mismatches = append(mismatches, "currency_options")
+			break
+		}
+	}
+	return mismatches
+}
+
+// priceCurrencyOptionExpands returns the fields to expand for the tiers of
+// the currency options of a price, which Stripe only returns when expanded by
+// currency.
+func priceCurrencyOptionExpands(currencyOptions types.Map) []string {
+	var expands []string
+	for currency, element := range currencyOptions.Elements() {
+		option, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		if tiers, ok := option.Attributes()["tiers"].(types.List); ok && !tiers.IsNull() {
+			expands = append(expands, "currency_options."+currency+".tiers")
+		}
+	}
+	slices.Sort(expands)
+	return expands
+}
+
+// findPriceByLookupKey returns the price with the given lookup key, or nil if
+// there is none. The product of the price is expanded when expandProduct is
+// set.
+func (r *PriceResource) findPriceByLookupKey(lookupKey string, expandProduct bool) (*stripe.Price, error) {
+	params := &stripe.PriceListParams{
+		LookupKeys: stripe.StringSlice([]string{lookupKey}),
+	}
+	params.Limit = stripe.Int64(1)
+	params.AddExpand("data.currency_options")
+	params.AddExpand("data.tiers")
+	if expandProduct {
+		params.AddExpand("data.product")
+	}
+	iter := r.sc.Prices.List(params)
+	if iter.Next() {
+		return iter.Price(), nil
+	}
+	return nil, iter.Err()
+}
+
+func (r *PriceResource) populateModel(ctx context.Context, model *PriceResourceModel, price *stripe.Price, respDiag *diag.Diagnostics) {
 	model.Active = types.BoolValue(price.Active)
 	model.BillingScheme = types.StringValue(string(price.BillingScheme))
+	model.Created = types.Int64Value(price.Created)
 	model.Currency = types.StringValue(string(price.Currency))
-	model.LookupKey = types.StringValue(price.LookupKey)
-	model.Nickname = types.StringValue(price.Nickname)
+	model.CurrencyOptions = r.populateCurrencyOptions(ctx, model.CurrencyOptions, price.Currency, price.CurrencyOptions, respDiag)
+	model.CustomUnitAmount = r.populateCustomUnitAmount(ctx, price.CustomUnitAmount, respDiag)
+	model.LookupKey = StringNullIfEmpty(price.LookupKey)
+	metadata, diags := types.MapValueFrom(ctx, types.StringType, price.Metadata)
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.MetadataKeys = metadataKeys(model.Metadata)
+	model.Nickname = StringNullIfEmpty(price.Nickname)
 	model.Product = types.StringValue(price.Product.ID)
+	// The product is only expanded when asked for, and otherwise only has
+	// its ID.
+	model.ProductDetails = types.ObjectNull(PriceProductDetails{}.Types())
+	if model.ExpandProduct.ValueBool() && price.Product.Name != "" {
+		productDetails, diags := types.ObjectValueFrom(ctx, PriceProductDetails{}.Types(), PriceProductDetails{
+			Active: types.BoolValue(price.Product.Active),
+			Name:   types.StringValue(price.Product.Name),
+		})
+		respDiag.Append(diags...)
+		model.ProductDetails = productDetails
+	}
+	model.Recurring = r.populateRecurring(ctx, price.Recurring, respDiag)
 	model.TaxBehavior = types.StringValue(string(price.TaxBehavior))
-	model.Tiers = types.List{}
-	model.TiersMode = types.StringValue(string(price.TiersMode))
-	model.UnitAmount = types.Int64Value(price.UnitAmount)
-	model.UnitAmountDecimal = types.Float64Value(price.UnitAmountDecimal)
+	model.Tiers = r.populateTiers(ctx, model.Tiers, price.Tiers, respDiag)
+	model.TiersMode = StringNullIfEmpty(string(price.TiersMode))
+	model.TransformQuantity = r.populateTransformQuantity(ctx, price.TransformQuantity, respDiag)
+	model.UnitAmount, model.UnitAmountDecimal = priceUnitAmount(price.UnitAmount, price.UnitAmountDecimal, model.UnitAmount, model.UnitAmountDecimal)
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"active":              model.Active,
+		"billing_scheme":      model.BillingScheme,
+		"currency":            model.Currency,
+		"currency_options":    model.CurrencyOptions,
+		"custom_unit_amount":  model.CustomUnitAmount,
+		"lookup_key":          model.LookupKey,
+		"metadata":            model.Metadata,
+		"nickname":            model.Nickname,
+		"product":             model.Product,
+		"recurring":           model.Recurring,
+		"tax_behavior":        model.TaxBehavior,
+		"tiers":               model.Tiers,
+		"tiers_mode":          model.TiersMode,
+		"transform_quantity":  model.TransformQuantity,
+		"unit_amount":         model.UnitAmount,
+		"unit_amount_decimal": model.UnitAmountDecimal,
+	})
+}
+
+// populateCurrencyOptions builds the currency options of a price from the
+// ones Stripe returned. They are only returned when expanded and always
+// include the top-level currency, so a single option is only kept when
+// currency options were configured.
+func (r *PriceResource) populateCurrencyOptions(ctx context.Context, priorCurrencyOptions types.Map, currency stripe.Currency, currencyOptions map[string]*stripe.PriceCurrencyOptions, respDiag *diag.Diagnostics) types.Map {
+	optionType := types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}
+	if len(currencyOptions) == 0 || (len(currencyOptions) == 1 && priorCurrencyOptions.IsNull()) {
+		if priorCurrencyOptions.IsNull() {
+			return types.MapNull(optionType)
+		}
+		return priorCurrencyOptions
+	}
+
+	var prior map[string]PriceCurrencyOptions
+	if !priorCurrencyOptions.IsNull() && !priorCurrencyOptions.IsUnknown() {
+		respDiag.Append(priorCurrencyOptions.ElementsAs(ctx, &prior, false)...)
+	}
+
+	options := make(map[string]PriceCurrencyOptions, len(currencyOptions))
+	for optionCurrency, pco := range currencyOptions {
+		// Stripe returns the amount in both forms, which are kept in the
+		// form they were configured like the top-level amount.
+		priorOption := prior[optionCurrency]
+		var customUnitAmount *stripe.PriceCustomUnitAmount
+		if pco.CustomUnitAmount != nil {
+			customUnitAmount = &stripe.PriceCustomUnitAmount{
+				Maximum: pco.CustomUnitAmount.Maximum,
+				Minimum: pco.CustomUnitAmount.Minimum,
+				Preset:  pco.CustomUnitAmount.Preset,
+			}
+		}
+		// Tiers are only returned when expanded, see priceCurrencyOptionExpands.
+		tiers := make([]*stripe.PriceTier, 0, len(pco.Tiers))
+		for _, tier := range pco.Tiers {
+			tiers = append(tiers, &stripe.PriceTier{
+				FlatAmount:        tier.FlatAmount,
+				FlatAmountDecimal: tier.FlatAmountDecimal,
+				UnitAmount:        tier.UnitAmount,
+				UnitAmountDecimal: tier.UnitAmountDecimal,
+				UpTo:              tier.UpTo,
+			})
+		}
+		option := PriceCurrencyOptions{
+			CustomUnitAmount: r.populateCustomUnitAmount(ctx, customUnitAmount, respDiag),
+			TaxBehavior:      types.StringValue(string(pco.TaxBehavior)),
+			Tiers:            r.populateTiers(ctx, priorOption.Tiers, tiers, respDiag),
+			TopLevel:         types.BoolValue(string(currency) == optionCurrency),
+		}
+		option.UnitAmount, option.UnitAmountDecimal = priceUnitAmount(pco.UnitAmount, pco.UnitAmountDecimal, priorOption.UnitAmount, priorOption.UnitAmountDecimal)
+		options[optionCurrency] = option
+	}
+
+	value, diags := types.MapValueFrom(ctx, optionType, options)
+	respDiag.Append(diags...)
+	return value
+}
+
+// populateCustomUnitAmount builds the custom unit amount of a price, which is
+// null for prices with a fixed amount.
+func (r *PriceResource) populateCustomUnitAmount(ctx context.Context, customUnitAmount *stripe.PriceCustomUnitAmount, respDiag *diag.Diagnostics) types.Object {
+	if customUnitAmount == nil {
+		return types.ObjectNull(PriceCustomUnitAmount{}.Types())
+	}
+
+	object, diags := types.ObjectValueFrom(ctx, PriceCustomUnitAmount{}.Types(), PriceCustomUnitAmount{
+		Maximum: Int64NullIfEmpty(customUnitAmount.Maximum),
+		Minimum: Int64NullIfEmpty(customUnitAmount.Minimum),
+		Preset:  Int64NullIfEmpty(customUnitAmount.Preset),
+	})
+	respDiag.Append(diags...)
+	return object
+}
+
+// populateTransformQuantity builds the quantity transformation of a price,
+// which is null when usage is billed as reported.
+func (r *PriceResource) populateTransformQuantity(ctx context.Context, transformQuantity *stripe.PriceTransformQuantity, respDiag *diag.Diagnostics) types.Object {
+	if transformQuantity == nil {
+		return types.ObjectNull(PriceTransformQuantity{}.Types())
+	}
+
+	object, diags := types.ObjectValueFrom(ctx, PriceTransformQuantity{}.Types(), PriceTransformQuantity{
+		DivideBy: types.Int64Value(transformQuantity.DivideBy),
+		Round:    types.StringValue(string(transformQuantity.Round)),
+	})
+	respDiag.Append(diags...)
+	return object
+}
+
+// populateRecurring builds the recurring components of a price from the ones
+// Stripe returned. A price without them is a one-time price. Stripe fills in
+// defaults that are left unset in the configuration, which are kept as
+// configured.
+func (r *PriceResource) populateRecurring(ctx context.Context, recurring *stripe.PriceRecurring, respDiag *diag.Diagnostics) types.Object {
+	if recurring == nil {
+		return types.ObjectNull(PriceRecurring{}.Types())
+	}
+
+	model := PriceRecurring{
+		Interval:       types.StringValue(string(recurring.Interval)),
+		AggregateUsage: types.StringValue(string(recurring.AggregateUsage)),
+		IntervalCount:  types.Int64Value(recurring.IntervalCount),
+		Meter:          StringNullIfEmpty(recurring.Meter),
+		UsageType:      types.StringValue(string(recurring.UsageType)),
+	}
+	// Licensed prices have no aggregate usage, which matches the schema
+	// default.
+	if recurring.AggregateUsage == "" {
+		model.AggregateUsage = types.StringValue("sum")
+	}
+
+	object, diags := types.ObjectValueFrom(ctx, PriceRecurring{}.Types(), model)
+	respDiag.Append(diags...)
+	return object
+}
+
+// priceUnitAmount returns the unit amount of a price in the form it was
+// configured in, either as integer or as decimal. Stripe returns both for
+// per-unit prices, and neither for tiered prices or prices with a custom unit
+// amount.
+func priceUnitAmount(amount int64, amountDecimal float64, prior types.Int64, priorDecimal types.Float64) (types.Int64, types.Float64) {
+	if (!priorDecimal.IsNull() && !priorDecimal.IsUnknown()) || amountDecimal != float64(amount) {
+		return types.Int64Null(), types.Float64Value(amountDecimal)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		return types.Int64Value(amount), types.Float64Null()
+	}
+	return Int64NullIfEmpty(amount), types.Float64Null()
 }
 
-func (r *PriceResource) buildCreateParams(plan PriceResourceModel) *stripe.PriceParams {
+// populateTiers builds the tiers of a price from the tiers Stripe returned,
+// using the prior tiers to keep amounts in the form they were configured.
+// Stripe returns up_to 0 for the final tier, meaning infinite, which is kept
+// as null.
+func (r *PriceResource) populateTiers(ctx context.Context, priorTiers types.List, tiers []*stripe.PriceTier, respDiag *diag.Diagnostics) types.List {
+	tierType := types.ObjectType{AttrTypes: PriceTierModel{}.Types()}
+	if len(tiers) == 0 {
+		return types.ListNull(tierType)
+	}
+
+	var prior []PriceTierModel
+	if !priorTiers.IsNull() && !priorTiers.IsUnknown() {
+		respDiag.Append(priorTiers.ElementsAs(ctx, &prior, false)...)
+	}
+
+	items := make([]PriceTierModel, 0, len(tiers))
+	for i, tier := range tiers {
+		var priorTier PriceTierModel
+		if i < len(prior) {
+			priorTier = prior[i]
+		}
+		item := PriceTierModel{
+			UpTo: Int64NullIfEmpty(tier.UpTo),
+		}
+		item.FlatAmount, item.FlatAmountDecimal = priceTierAmount(tier.FlatAmount, tier.FlatAmountDecimal, priorTier.FlatAmount, priorTier.FlatAmountDecimal)
+		item.UnitAmount, item.UnitAmountDecimal = priceTierAmount(tier.UnitAmount, tier.UnitAmountDecimal, priorTier.UnitAmount, priorTier.UnitAmountDecimal)
+		items = append(items, item)
+	}
+
+	list, diags := types.ListValueFrom(ctx, tierType, items)
+	respDiag.Append(diags...)
+	return list
+}
+
+// priceTierAmount returns a tier amount in either its integer or its decimal
+// form, as Stripe returns both. The decimal form is used when it was
+// configured or the amount has a fractional part.
+func priceTierAmount(amount int64, amountDecimal float64, prior types.Int64, priorDecimal types.String) (types.Int64, types.String) {
+	if !priorDecimal.IsNull() || amountDecimal != float64(amount) {
+		// Keep the configured formatting of an unchanged decimal amount.
+		if value, err := strconv.ParseFloat(priorDecimal.ValueString(), 64); err == nil && value == amountDecimal {
+			return types.Int64Null(), priorDecimal
+		}
+		return types.Int64Null(), types.StringValue(strconv.FormatFloat(amountDecimal, 'f', -1, 64))
+	}
+	if !prior.IsNull() {
+		return types.Int64Value(amount), types.StringNull()
+	}
+	return Int64NullIfEmpty(amount), types.StringNull()
+}
+
+func (r *PriceResource) buildCreateParams(ctx context.Context, plan PriceResourceModel, respDiag *diag.Diagnostics) *stripe.PriceParams {
 	params := &stripe.PriceParams{}
+	if !plan.Active.IsUnknown() && !plan.Active.IsNull() {
+		params.Active = plan.Active.ValueBoolPointer()
+	}
+	if !plan.Currency.IsUnknown() && !plan.Currency.IsNull() {
+		params.Currency = plan.Currency.ValueStringPointer()
+	}
+	if !plan.CustomUnitAmount.IsUnknown() && !plan.CustomUnitAmount.IsNull() {
+		var cua PriceCustomUnitAmount
+		diags := plan.CustomUnitAmount.As(ctx, &cua, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		params.CustomUnitAmount = &stripe.PriceCustomUnitAmountParams{
+			Enabled: stripe.Bool(true),
+			Maximum: cua.Maximum.ValueInt64Pointer(),
+			Minimum: cua.Minimum.ValueInt64Pointer(),
+			Preset:  cua.Preset.ValueInt64Pointer(),
+		}
+	}
+	if !plan.CurrencyOptions.IsUnknown() && !plan.CurrencyOptions.IsNull() {
+		currencyOptions := map[string]PriceCurrencyOptions{}
+		params.CurrencyOptions = map[string]*stripe.PriceCurrencyOptionsParams{}
+		diags := plan.CurrencyOptions.ElementsAs(ctx, &currencyOptions, false)
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		for key, element := range currencyOptions {
+			var cua *PriceCustomUnitAmount
+			if !element.CustomUnitAmount.IsUnknown() && !element.CustomUnitAmount.IsNull() {
+				cua = &PriceCustomUnitAmount{}
+				diags = element.CustomUnitAmount.As(ctx, cua, basetypes.ObjectAsOptions{})
+				if diags.HasError() {
+					respDiag.Append(diags...)
+				}
+			}
+			var tiers []PriceTierModel
+			if !element.Tiers.IsUnknown() && !element.Tiers.IsNull() {
+				diags = element.Tiers.ElementsAs(ctx, &tiers, false)
+				if diags.HasError() {
+					respDiag.Append(diags...)
+				}
+			}
+			if element.TopLevel.ValueBool() {
+				params.Currency = stripe.String(key)
+				params.UnitAmount = element.UnitAmount.ValueInt64Pointer()
+				params.UnitAmountDecimal = element.UnitAmountDecimal.ValueFloat64Pointer()
+				params.TaxBehavior = element.TaxBehavior.ValueStringPointer()
+				if cua != nil {
+					params.CustomUnitAmount = &stripe.PriceCustomUnitAmountParams{
+						Enabled: stripe.Bool(true),
+						Maximum: cua.Maximum.ValueInt64Pointer(),
+						Minimum: cua.Minimum.ValueInt64Pointer(),
+						Preset:  cua.Preset.ValueInt64Pointer(),
+					}
+				}
+			} else {
+				params.CurrencyOptions[key] = buildPriceCurrencyOptionParams(cua, tiers, element)
+			}
+		}
+	}
+	if !plan.BillingScheme.IsUnknown() && !plan.BillingScheme.IsNull() {
+		params.BillingScheme = plan.BillingScheme.ValueStringPointer()
+	}
+	if !plan.LookupKey.IsUnknown() && !plan.LookupKey.IsNull() {
+		params.LookupKey = plan.LookupKey.ValueStringPointer()
+	}
+	if !plan.Metadata.IsUnknown() && !plan.Metadata.IsNull() {
+		for k, v := range plan.Metadata.Elements() {
+			if str, ok := v.(types.String); ok {
+				params.AddMetadata(k, str.ValueString())
+			}
+		}
+	}
+	if !plan.Nickname.IsUnknown() && !plan.Nickname.IsNull() {
+		params.Nickname = plan.Nickname.ValueStringPointer()
+	}
+	if !plan.Product.IsUnknown() && !plan.Product.IsNull() {
+		params.Product = plan.Product.ValueStringPointer()
+	}
+	if !plan.Recurring.IsUnknown() && !plan.Recurring.IsNull() {
+		var recurring PriceRecurring
+		diags := plan.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		params.Recurring = &stripe.PriceRecurringParams{
+			Interval:      recurring.Interval.ValueStringPointer(),
+			IntervalCount: recurring.IntervalCount.ValueInt64Pointer(),
+			Meter:         recurring.Meter.ValueStringPointer(),
+			UsageType:     recurring.UsageType.ValueStringPointer(),
+		}
+		// Stripe rejects an aggregate usage for licensed prices.
+		if recurring.UsageType.ValueString() == "metered" {
+			params.Recurring.AggregateUsage = recurring.AggregateUsage.ValueStringPointer()
+		}
+	}
+	if !plan.TaxBehavior.IsUnknown() && !plan.TaxBehavior.IsNull() {
+		params.TaxBehavior = plan.TaxBehavior.ValueStringPointer()
+	}
+	if !plan.Tiers.IsUnknown() && !plan.Tiers.IsNull() {
+		var tiers []PriceTierModel
+		diags := plan.Tiers.ElementsAs(ctx, &tiers, false)
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		for _, tier := range tiers {
+			params.Tiers = append(params.Tiers, buildPriceTierParams(tier))
+		}
+	}
+	if !plan.TiersMode.IsUnknown() && !plan.TiersMode.IsNull() {
+		params.TiersMode = plan.TiersMode.ValueStringPointer()
+	}
+	if !plan.TransformQuantity.IsUnknown() && !plan.TransformQuantity.IsNull() {
+		var transformQuantity PriceTransformQuantity
+		diags := plan.TransformQuantity.As(ctx, &transformQuantity, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		params.TransformQuantity = &stripe.PriceTransformQuantityParams{
+			DivideBy: transformQuantity.DivideBy.ValueInt64Pointer(),
+			Round:    transformQuantity.Round.ValueStringPointer(),
+		}
+	}
+	if !plan.UnitAmount.IsUnknown() && !plan.UnitAmount.IsNull() {
+		params.UnitAmount = plan.UnitAmount.ValueInt64Pointer()
+	}
+	if !plan.UnitAmountDecimal.IsUnknown() && !plan.UnitAmountDecimal.IsNull() {
+		params.UnitAmountDecimal = plan.UnitAmountDecimal.ValueFloat64Pointer()
+	}
 	return params
 }
 
-func (r *PriceResource) buildUpdateParams(state, plan PriceResourceModel) *stripe.PriceParams {
+// buildPriceTierParams returns the params of a price tier. A tier without
+// up_to covers all remaining quantities, which Stripe takes as up_to=inf.
+func buildPriceTierParams(tier PriceTierModel) *stripe.PriceTierParams {
+	params := &stripe.PriceTierParams{
+		FlatAmount: tier.FlatAmount.ValueInt64Pointer(),
+		UnitAmount: tier.UnitAmount.ValueInt64Pointer(),
+	}
+	// The decimal amounts are validated by the schema.
+	if value, err := strconv.ParseFloat(tier.FlatAmountDecimal.ValueString(), 64); err == nil {
+		params.FlatAmountDecimal = stripe.Float64(value)
+	}
+	if value, err := strconv.ParseFloat(tier.UnitAmountDecimal.ValueString(), 64); err == nil {
+		params.UnitAmountDecimal = stripe.Float64(value)
+	}
+	if tier.UpTo.IsNull() {
+		params.UpToInf = stripe.Bool(true)
+	} else {
+		params.UpTo = tier.UpTo.ValueInt64Pointer()
+	}
+	return params
+}
+
+// buildPriceCurrencyOptionParams returns the params of a currency option that
+// is not the top-level currency of a price.
+func buildPriceCurrencyOptionParams(cua *PriceCustomUnitAmount, tiers []PriceTierModel, element PriceCurrencyOptions) *stripe.PriceCurrencyOptionsParams {
+	pco := &stripe.PriceCurrencyOptionsParams{
+		UnitAmount:        element.UnitAmount.ValueInt64Pointer(),
+		UnitAmountDecimal: element.UnitAmountDecimal.ValueFloat64Pointer(),
+		TaxBehavior:       element.TaxBehavior.ValueStringPointer(),
+	}
+	for _, tier := range tiers {
+		tierParams := buildPriceTierParams(tier)
+		pco.Tiers = append(pco.Tiers, &stripe.PriceCurrencyOptionsTierParams{
+			FlatAmount:        tierParams.FlatAmount,
+			FlatAmountDecimal: tierParams.FlatAmountDecimal,
+			UnitAmount:        tierParams.UnitAmount,
+			UnitAmountDecimal: tierParams.UnitAmountDecimal,
+			UpTo:              tierParams.UpTo,
+			UpToInf:           tierParams.UpToInf,
+		})
+	}
+	if cua != nil {
+		pco.CustomUnitAmount = &stripe.PriceCurrencyOptionsCustomUnitAmountParams{
+			Enabled: stripe.Bool(true),
+			Maximum: cua.Maximum.ValueInt64Pointer(),
+			Minimum: cua.Minimum.ValueInt64Pointer(),
+			Preset:  cua.Preset.ValueInt64Pointer(),
+		}
+	}
+	return pco
+}
+
+// buildUpdateParams only sends the fields that Stripe allows to be updated on
+// a price and that changed.
+func (r *PriceResource) buildUpdateParams(ctx context.Context, state, plan PriceResourceModel, respDiag *diag.Diagnostics) *stripe.PriceParams {
 	params := &stripe.PriceParams{}
+	// Also re-activates prices that were archived outside of Terraform.
+	if !plan.Active.IsUnknown() && !plan.Active.Equal(state.Active) {
+		params.Active = plan.Active.ValueBoolPointer()
+	}
+
+	// Added and changed currency options are sent, the top-level currency is
+	// part of the price itself and cannot be changed.
+	if !plan.CurrencyOptions.IsUnknown() && !plan.CurrencyOptions.IsNull() && !plan.CurrencyOptions.Equal(state.CurrencyOptions) {
+		planElements := plan.CurrencyOptions.Elements()
+		stateElements := state.CurrencyOptions.Elements()
+		planCurrencyOptions := map[string]PriceCurrencyOptions{}
+		diags := plan.CurrencyOptions.ElementsAs(ctx, &planCurrencyOptions, false)
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		for key, element := range planCurrencyOptions {
+			if element.TopLevel.ValueBool() || planElements[key].Equal(stateElements[key]) {
+				continue
+			}
+			var cua *PriceCustomUnitAmount
+			if !element.CustomUnitAmount.IsUnknown() && !element.CustomUnitAmount.IsNull() {
+				cua = &PriceCustomUnitAmount{}
+				diags = element.CustomUnitAmount.As(ctx, cua, basetypes.ObjectAsOptions{})
+				if diags.HasError() {
+					respDiag.Append(diags...)
+				}
+			}
+			var tiers []PriceTierModel
+			if !element.Tiers.IsUnknown() && !element.Tiers.IsNull() {
+				diags = element.Tiers.ElementsAs(ctx, &tiers, false)
+				if diags.HasError() {
+					respDiag.Append(diags...)
+				}
+			}
+			if params.CurrencyOptions == nil {
+				params.CurrencyOptions = map[string]*stripe.PriceCurrencyOptionsParams{}
+			}
+			params.CurrencyOptions[key] = buildPriceCurrencyOptionParams(cua, tiers, element)
+		}
+	}
+
+	if !plan.LookupKey.IsUnknown() && !plan.LookupKey.Equal(state.LookupKey) {
+		params.LookupKey = EmptyStringIfNull(plan.LookupKey)
+	}
+
+	if !plan.Metadata.IsUnknown() && !plan.Metadata.Equal(state.Metadata) {
+		planMetadata := plan.Metadata.Elements()
+		stateMetadata := state.Metadata.Elements()
+		for k, v := range planMetadata {
+			if str, ok := v.(types.String); ok {
+				params.AddMetadata(k, str.ValueString())
+			}
+		}
+		for k := range stateMetadata {
+			if _, exists := planMetadata[k]; !exists {
+				params.AddMetadata(k, "")
+			}
+		}
+	}
+
+	if !plan.Nickname.IsUnknown() && !plan.Nickname.Equal(state.Nickname) {
+		params.Nickname = EmptyStringIfNull(plan.Nickname)
+	}
+
+	if !plan.TaxBehavior.IsUnknown() && !plan.TaxBehavior.IsNull() && !plan.TaxBehavior.Equal(state.TaxBehavior) {
+		params.TaxBehavior = plan.TaxBehavior.ValueStringPointer()
+	}
+
 	return params
 }
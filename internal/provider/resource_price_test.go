@@ -3,6 +3,1414 @@
 
 package provider
 
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v81"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestPopulateModelPriceResourceCreated(t *testing.T) {
+	r := &PriceResource{}
+	var model PriceResourceModel
+	r.populateModel(context.Background(), &model, &stripe.Price{
+		ID:       "price_123",
+		Created:  int64(1700000000),
+		Currency: stripe.CurrencyUSD,
+		Product:  &stripe.Product{ID: "prod_123"},
+	}, &diag.Diagnostics{})
+
+	assert.Equal(t, types.Int64Value(1700000000), model.Created)
+}
+
+func TestPopulateModelPriceResource(t *testing.T) {
+	tierType := types.ObjectType{AttrTypes: PriceTierModel{}.Types()}
+
+	tests := []struct {
+		name                  string
+		prior                 PriceResourceModel
+		price                 *stripe.Price
+		wantRecurring         types.Object
+		wantTiers             types.List
+		wantTransformQuantity types.Object
+		wantUnitAmount        types.Int64
+		wantUnitAmountDecimal types.Float64
+	}{
+		{
+			name: "recurring metered price",
+			prior: PriceResourceModel{
+				UnitAmount:        types.Int64Unknown(),
+				UnitAmountDecimal: types.Float64Value(0.25),
+			},
+			price: &stripe.Price{
+				ID:            "price_123",
+				BillingScheme: stripe.PriceBillingSchemePerUnit,
+				Currency:      stripe.CurrencyUSD,
+				Product:       &stripe.Product{ID: "prod_123"},
+				Recurring: &stripe.PriceRecurring{
+					AggregateUsage: stripe.PriceRecurringAggregateUsageMax,
+					Interval:       stripe.PriceRecurringIntervalMonth,
+					IntervalCount:  3,
+					Meter:          "mtr_123",
+					UsageType:      stripe.PriceRecurringUsageTypeMetered,
+				},
+				TransformQuantity: &stripe.PriceTransformQuantity{
+					DivideBy: 1000,
+					Round:    stripe.PriceTransformQuantityRoundUp,
+				},
+				UnitAmountDecimal: 0.25,
+			},
+			wantRecurring: types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+				"interval":        types.StringValue("month"),
+				"aggregate_usage": types.StringValue("max"),
+				"interval_count":  types.Int64Value(3),
+				"meter":           types.StringValue("mtr_123"),
+				"usage_type":      types.StringValue("metered"),
+			}),
+			wantTiers: types.ListNull(tierType),
+			wantTransformQuantity: types.ObjectValueMust(PriceTransformQuantity{}.Types(), map[string]attr.Value{
+				"divide_by": types.Int64Value(1000),
+				"round":     types.StringValue("up"),
+			}),
+			wantUnitAmount:        types.Int64Null(),
+			wantUnitAmountDecimal: types.Float64Value(0.25),
+		},
+		{
+			name: "tiered price",
+			prior: PriceResourceModel{
+				UnitAmount:        types.Int64Unknown(),
+				UnitAmountDecimal: types.Float64Unknown(),
+			},
+			price: &stripe.Price{
+				ID:            "price_123",
+				BillingScheme: stripe.PriceBillingSchemeTiered,
+				Currency:      stripe.CurrencyUSD,
+				Product:       &stripe.Product{ID: "prod_123"},
+				Recurring: &stripe.PriceRecurring{
+					Interval:      stripe.PriceRecurringIntervalMonth,
+					IntervalCount: 1,
+					UsageType:     stripe.PriceRecurringUsageTypeLicensed,
+				},
+				Tiers: []*stripe.PriceTier{
+					{UnitAmount: 1000, UnitAmountDecimal: 1000, UpTo: 10},
+					{UnitAmount: 800, UnitAmountDecimal: 800},
+				},
+				TiersMode: stripe.PriceTiersModeGraduated,
+			},
+			wantRecurring: types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+				"interval":        types.StringValue("month"),
+				"aggregate_usage": types.StringValue("sum"),
+				"interval_count":  types.Int64Value(1),
+				"meter":           types.StringNull(),
+				"usage_type":      types.StringValue("licensed"),
+			}),
+			wantTiers: testListValue(t, tierType, []PriceTierModel{
+				{
+					FlatAmount:        types.Int64Null(),
+					FlatAmountDecimal: types.StringNull(),
+					UnitAmount:        types.Int64Value(1000),
+					UnitAmountDecimal: types.StringNull(),
+					UpTo:              types.Int64Value(10),
+				},
+				{
+					FlatAmount:        types.Int64Null(),
+					FlatAmountDecimal: types.StringNull(),
+					UnitAmount:        types.Int64Value(800),
+					UnitAmountDecimal: types.StringNull(),
+					UpTo:              types.Int64Null(),
+				},
+			}),
+			wantTransformQuantity: types.ObjectNull(PriceTransformQuantity{}.Types()),
+			wantUnitAmount:        types.Int64Null(),
+			wantUnitAmountDecimal: types.Float64Null(),
+		},
+		{
+			name: "one-off price",
+			prior: PriceResourceModel{
+				UnitAmount:        types.Int64Value(1500),
+				UnitAmountDecimal: types.Float64Unknown(),
+			},
+			price: &stripe.Price{
+				ID:                "price_123",
+				BillingScheme:     stripe.PriceBillingSchemePerUnit,
+				Currency:          stripe.CurrencyUSD,
+				Product:           &stripe.Product{ID: "prod_123"},
+				UnitAmount:        1500,
+				UnitAmountDecimal: 1500,
+			},
+			wantRecurring:         types.ObjectNull(PriceRecurring{}.Types()),
+			wantTiers:             types.ListNull(tierType),
+			wantTransformQuantity: types.ObjectNull(PriceTransformQuantity{}.Types()),
+			wantUnitAmount:        types.Int64Value(1500),
+			wantUnitAmountDecimal: types.Float64Null(),
+		}, {
+			name: "empty price",
+			price: &stripe.Price{
+				ID:      "price_123",
+				Product: &stripe.Product{ID: "prod_123"},
+			},
+			wantRecurring:         types.ObjectNull(PriceRecurring{}.Types()),
+			wantTiers:             types.ListNull(tierType),
+			wantTransformQuantity: types.ObjectNull(PriceTransformQuantity{}.Types()),
+			wantUnitAmount:        types.Int64Null(),
+			wantUnitAmountDecimal: types.Float64Null(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			model := tt.prior
+			diags := diag.Diagnostics{}
+			r.populateModel(context.Background(), &model, tt.price, &diags)
+			require.False(t, diags.HasError(), diags)
+
+			assert.Equal(t, tt.wantRecurring, model.Recurring)
+			assert.Equal(t, tt.wantTiers, model.Tiers)
+			assert.Equal(t, tt.wantTransformQuantity, model.TransformQuantity)
+			assert.Equal(t, tt.wantUnitAmount, model.UnitAmount)
+			assert.Equal(t, tt.wantUnitAmountDecimal, model.UnitAmountDecimal)
+		})
+	}
+}
+
+func TestPopulateRecurringPriceResource(t *testing.T) {
+	recurring := func(intervalCount types.Int64) types.Object {
+		return types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+			"interval":        types.StringValue("month"),
+			"aggregate_usage": types.StringValue("sum"),
+			"interval_count":  intervalCount,
+			"meter":           types.StringNull(),
+			"usage_type":      types.StringValue("licensed"),
+		})
+	}
+	monthly := func(intervalCount int64) *stripe.PriceRecurring {
+		return &stripe.PriceRecurring{
+			Interval:      stripe.PriceRecurringIntervalMonth,
+			IntervalCount: intervalCount,
+			UsageType:     stripe.PriceRecurringUsageTypeLicensed,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		recurring *stripe.PriceRecurring
+		want      types.Object
+	}{
+		{"one-off", nil, types.ObjectNull(PriceRecurring{}.Types())},
+		{"monthly", monthly(1), recurring(types.Int64Value(1))},
+		{"quarterly", monthly(3), recurring(types.Int64Value(3))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			diags := diag.Diagnostics{}
+			got := r.populateRecurring(context.Background(), tt.recurring, &diags)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpgradeStatePriceResource(t *testing.T) {
+	tests := []struct {
+		name              string
+		recurring         string
+		wantIntervalCount types.Int64
+		wantErr           bool
+	}{
+		{"interval count", `{"interval": "month", "interval_count": "3"}`, types.Int64Value(3), false},
+		{"empty interval count", `{"interval": "month", "interval_count": ""}`, types.Int64Value(1), false},
+		{"no interval count", `{"interval": "month", "interval_count": null}`, types.Int64Value(1), false},
+		{"invalid interval count", `{"interval": "month", "interval_count": "monthly"}`, types.Int64Null(), true},
+	}
+
+	ctx := context.Background()
+	r := &PriceResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	require.True(t, ok)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(`{
+					"id": "price_123",
+					"currency": "usd",
+					"product": "prod_123",
+					"recurring": ` + tt.recurring + `,
+					"unit_amount": 1500
+				}`)},
+			}
+			resp := &fwresource.UpgradeStateResponse{}
+			upgrader.StateUpgrader(ctx, req, resp)
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tt.wantErr {
+				return
+			}
+
+			raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+			require.NoError(t, err)
+			var state PriceResourceModel
+			require.False(t, tfsdk.State{Schema: schemaResp.Schema, Raw: raw}.Get(ctx, &state).HasError())
+			var recurring PriceRecurring
+			require.False(t, state.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{}).HasError())
+			assert.Equal(t, types.StringValue("month"), recurring.Interval)
+			assert.Equal(t, tt.wantIntervalCount, recurring.IntervalCount)
+			assert.Equal(t, types.Int64Value(1500), state.UnitAmount)
+		})
+	}
+}
+
+func TestPriceUnitAmount(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        int64
+		amountDecimal float64
+		prior         types.Int64
+		priorDecimal  types.Float64
+		want          types.Int64
+		wantDecimal   types.Float64
+	}{
+		{"not set", 0, 0, types.Int64Null(), types.Float64Null(), types.Int64Null(), types.Float64Null()},
+		{"integer", 1500, 1500, types.Int64Null(), types.Float64Null(), types.Int64Value(1500), types.Float64Null()},
+		{"configured zero", 0, 0, types.Int64Value(0), types.Float64Null(), types.Int64Value(0), types.Float64Null()},
+		{"fractional", 0, 0.25, types.Int64Null(), types.Float64Null(), types.Int64Null(), types.Float64Value(0.25)},
+		{"configured decimal", 1500, 1500, types.Int64Null(), types.Float64Value(1500), types.Int64Null(), types.Float64Value(1500)},
+		{"configured decimal zero", 0, 0, types.Int64Null(), types.Float64Value(0), types.Int64Null(), types.Float64Value(0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotDecimal := priceUnitAmount(tt.amount, tt.amountDecimal, tt.prior, tt.priorDecimal)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDecimal, gotDecimal)
+		})
+	}
+}
+
+func TestPopulateModelPriceResourceMetadataKeys(t *testing.T) {
+	r := &PriceResource{}
+	var model PriceResourceModel
+	diags := diag.Diagnostics{}
+	r.populateModel(context.Background(), &model, &stripe.Price{
+		ID:       "price_123",
+		Currency: stripe.CurrencyUSD,
+		Metadata: map[string]string{"team": "billing", "plan": "standard"},
+		Product:  &stripe.Product{ID: "prod_123"},
+	}, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, testListValue(t, types.StringType, []string{"plan", "team"}), model.MetadataKeys)
+}
+
+func TestPopulateModelPriceResourceProductDetails(t *testing.T) {
+	price := &stripe.Price{
+		ID:       "price_123",
+		Currency: stripe.CurrencyUSD,
+		Product:  &stripe.Product{ID: "prod_123", Active: true, Name: "Standard"},
+	}
+
+	r := &PriceResource{}
+	model := PriceResourceModel{ExpandProduct: types.BoolValue(true)}
+	diags := diag.Diagnostics{}
+	r.populateModel(context.Background(), &model, price, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, types.StringValue("prod_123"), model.Product)
+	assert.Equal(t, types.ObjectValueMust(PriceProductDetails{}.Types(), map[string]attr.Value{
+		"active": types.BoolValue(true),
+		"name":   types.StringValue("Standard"),
+	}), model.ProductDetails)
+
+	// Without expand_product the details are left out even if Stripe
+	// returned them.
+	model = PriceResourceModel{ExpandProduct: types.BoolNull()}
+	r.populateModel(context.Background(), &model, price, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, types.ObjectNull(PriceProductDetails{}.Types()), model.ProductDetails)
+
+	// An unexpanded product only has its ID.
+	model = PriceResourceModel{ExpandProduct: types.BoolValue(true)}
+	r.populateModel(context.Background(), &model, &stripe.Price{
+		ID:       "price_123",
+		Currency: stripe.CurrencyUSD,
+		Product:  &stripe.Product{ID: "prod_123"},
+	}, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	assert.Equal(t, types.ObjectNull(PriceProductDetails{}.Types()), model.ProductDetails)
+}
+
+func TestPopulateModelPriceResourceCurrencyOptions(t *testing.T) {
+	r := &PriceResource{}
+	var model PriceResourceModel
+	r.populateModel(context.Background(), &model, &stripe.Price{
+		ID:       "price_123",
+		Currency: stripe.CurrencyUSD,
+		CurrencyOptions: map[string]*stripe.PriceCurrencyOptions{
+			"usd": {
+				CustomUnitAmount: &stripe.PriceCurrencyOptionsCustomUnitAmount{
+					Maximum: 10000,
+					Minimum: 500,
+					Preset:  1000,
+				},
+				TaxBehavior: stripe.PriceCurrencyOptionsTaxBehaviorUnspecified,
+			},
+			"eur": {
+				CustomUnitAmount: &stripe.PriceCurrencyOptionsCustomUnitAmount{
+					Maximum: 9000,
+					Minimum: 450,
+					Preset:  900,
+				},
+				TaxBehavior: stripe.PriceCurrencyOptionsTaxBehaviorUnspecified,
+			},
+		},
+		CustomUnitAmount: &stripe.PriceCustomUnitAmount{
+			Maximum: 10000,
+			Minimum: 500,
+			Preset:  1000,
+		},
+		Product:     &stripe.Product{ID: "prod_123"},
+		TaxBehavior: stripe.PriceTaxBehaviorUnspecified,
+	}, &diag.Diagnostics{})
+
+	want := types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: PriceCurrencyOptions{}.Types(),
+		},
+		map[string]attr.Value{
+			"usd": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+				"custom_unit_amount": types.ObjectValueMust(PriceCustomUnitAmount{}.Types(), map[string]attr.Value{
+					"maximum": types.Int64Value(10000),
+					"minimum": types.Int64Value(500),
+					"preset":  types.Int64Value(1000),
+				}),
+				"tax_behavior":        types.StringValue("unspecified"),
+				"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+				"unit_amount":         types.Int64Null(),
+				"unit_amount_decimal": types.Float64Null(),
+				"top_level":           types.BoolValue(true),
+			}),
+			"eur": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+				"custom_unit_amount": types.ObjectValueMust(PriceCustomUnitAmount{}.Types(), map[string]attr.Value{
+					"maximum": types.Int64Value(9000),
+					"minimum": types.Int64Value(450),
+					"preset":  types.Int64Value(900),
+				}),
+				"tax_behavior":        types.StringValue("unspecified"),
+				"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+				"unit_amount":         types.Int64Null(),
+				"unit_amount_decimal": types.Float64Null(),
+				"top_level":           types.BoolValue(false),
+			}),
+		},
+	)
+	assert.Equal(t, want, model.CurrencyOptions)
+	assert.Equal(t, types.ObjectValueMust(PriceCustomUnitAmount{}.Types(), map[string]attr.Value{
+		"maximum": types.Int64Value(10000),
+		"minimum": types.Int64Value(500),
+		"preset":  types.Int64Value(1000),
+	}), model.CustomUnitAmount)
+}
+
+func TestPopulateCurrencyOptionsPriceResource(t *testing.T) {
+	optionType := types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}
+	usdOption := types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+		"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+		"tax_behavior":        types.StringValue("exclusive"),
+		"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+		"unit_amount":         types.Int64Value(1500),
+		"unit_amount_decimal": types.Float64Null(),
+		"top_level":           types.BoolValue(true),
+	})
+	usdDecimalOption := types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+		"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+		"tax_behavior":        types.StringValue("exclusive"),
+		"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+		"unit_amount":         types.Int64Null(),
+		"unit_amount_decimal": types.Float64Value(1500),
+		"top_level":           types.BoolValue(true),
+	})
+	usdOnly := map[string]*stripe.PriceCurrencyOptions{
+		"usd": {
+			TaxBehavior:       stripe.PriceCurrencyOptionsTaxBehaviorExclusive,
+			UnitAmount:        1500,
+			UnitAmountDecimal: 1500,
+		},
+	}
+
+	tests := []struct {
+		name            string
+		prior           types.Map
+		currencyOptions map[string]*stripe.PriceCurrencyOptions
+		want            types.Map
+	}{
+		{"not expanded", types.MapNull(optionType), nil, types.MapNull(optionType)},
+		{"not expanded, configured", types.MapValueMust(optionType, map[string]attr.Value{"usd": usdOption}), nil, types.MapValueMust(optionType, map[string]attr.Value{"usd": usdOption})},
+		{"top-level only", types.MapNull(optionType), usdOnly, types.MapNull(optionType)},
+		{"top-level only, configured", types.MapValueMust(optionType, map[string]attr.Value{}), usdOnly, types.MapValueMust(optionType, map[string]attr.Value{"usd": usdOption})},
+		{"decimal configured", types.MapValueMust(optionType, map[string]attr.Value{"usd": usdDecimalOption}), usdOnly, types.MapValueMust(optionType, map[string]attr.Value{"usd": usdDecimalOption})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			diags := diag.Diagnostics{}
+			got := r.populateCurrencyOptions(context.Background(), tt.prior, stripe.CurrencyUSD, tt.currencyOptions, &diags)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBuildCreateParamsPriceResource(t *testing.T) {
+	cases := []struct {
+		name string
+		data PriceResourceModel
+		want *stripe.PriceParams
+	}{
+		{
+			name: "Empty price options",
+			data: PriceResourceModel{},
+			want: &stripe.PriceParams{},
+		},
+		{
+			name: "Multi-currency price options",
+			data: PriceResourceModel{
+				Currency: types.StringUnknown(),
+				CurrencyOptions: types.MapValueMust(
+					types.ObjectType{
+						AttrTypes: PriceCurrencyOptions{}.Types(),
+					},
+					map[string]attr.Value{
+						"usd": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+							"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+							"tax_behavior":        types.StringValue("exclusive"),
+							"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+							"unit_amount":         types.Int64Value(1000),
+							"unit_amount_decimal": types.Float64Null(),
+							"top_level":           types.BoolValue(true),
+						}),
+						"eur": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+							"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+							"tax_behavior":        types.StringValue("inclusive"),
+							"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+							"unit_amount":         types.Int64Value(900),
+							"unit_amount_decimal": types.Float64Null(),
+							"top_level":           types.BoolValue(false),
+						}),
+					},
+				),
+				Product: types.StringValue("prod_123"),
+			},
+			want: &stripe.PriceParams{
+				Currency: stripe.String("usd"),
+				CurrencyOptions: map[string]*stripe.PriceCurrencyOptionsParams{
+					"eur": {
+						TaxBehavior: stripe.String("inclusive"),
+						UnitAmount:  stripe.Int64(900),
+					},
+				},
+				Product:     stripe.String("prod_123"),
+				TaxBehavior: stripe.String("exclusive"),
+				UnitAmount:  stripe.Int64(1000),
+			},
+		},
+		{
+			name: "Multi-currency custom unit amounts",
+			data: PriceResourceModel{
+				Currency: types.StringUnknown(),
+				CurrencyOptions: types.MapValueMust(
+					types.ObjectType{
+						AttrTypes: PriceCurrencyOptions{}.Types(),
+					},
+					map[string]attr.Value{
+						"usd": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+							"custom_unit_amount": types.ObjectValueMust(PriceCustomUnitAmount{}.Types(), map[string]attr.Value{
+								"maximum": types.Int64Value(10000),
+								"minimum": types.Int64Value(500),
+								"preset":  types.Int64Value(1000),
+							}),
+							"tax_behavior":        types.StringValue("unspecified"),
+							"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+							"unit_amount":         types.Int64Null(),
+							"unit_amount_decimal": types.Float64Null(),
+							"top_level":           types.BoolValue(true),
+						}),
+						"eur": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+							"custom_unit_amount": types.ObjectValueMust(PriceCustomUnitAmount{}.Types(), map[string]attr.Value{
+								"maximum": types.Int64Value(9000),
+								"minimum": types.Int64Value(450),
+								"preset":  types.Int64Value(900),
+							}),
+							"tax_behavior":        types.StringValue("unspecified"),
+							"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+							"unit_amount":         types.Int64Null(),
+							"unit_amount_decimal": types.Float64Null(),
+							"top_level":           types.BoolValue(false),
+						}),
+					},
+				),
+				Product: types.StringValue("prod_123"),
+			},
+			want: &stripe.PriceParams{
+				Currency: stripe.String("usd"),
+				CurrencyOptions: map[string]*stripe.PriceCurrencyOptionsParams{
+					"eur": {
+						CustomUnitAmount: &stripe.PriceCurrencyOptionsCustomUnitAmountParams{
+							Enabled: stripe.Bool(true),
+							Maximum: stripe.Int64(9000),
+							Minimum: stripe.Int64(450),
+							Preset:  stripe.Int64(900),
+						},
+						TaxBehavior: stripe.String("unspecified"),
+					},
+				},
+				CustomUnitAmount: &stripe.PriceCustomUnitAmountParams{
+					Enabled: stripe.Bool(true),
+					Maximum: stripe.Int64(10000),
+					Minimum: stripe.Int64(500),
+					Preset:  stripe.Int64(1000),
+				},
+				Product:     stripe.String("prod_123"),
+				TaxBehavior: stripe.String("unspecified"),
+			},
+		},
+		{
+			name: "Per-unit price options",
+			data: PriceResourceModel{
+				Active:        types.BoolValue(true),
+				BillingScheme: types.StringValue("per_unit"),
+				Currency:      types.StringValue("usd"),
+				LookupKey:     types.StringValue("standard_monthly"),
+				Metadata:      testMapValue(t, types.StringType, map[string]interface{}{"plan": "standard"}),
+				Nickname:      types.StringValue("Standard monthly"),
+				Product:       types.StringValue("prod_123"),
+				TaxBehavior:   types.StringValue("inclusive"),
+				TransformQuantity: types.ObjectValueMust(PriceTransformQuantity{}.Types(), map[string]attr.Value{
+					"divide_by": types.Int64Value(10),
+					"round":     types.StringValue("up"),
+				}),
+				UnitAmount:        types.Int64Value(1500),
+				UnitAmountDecimal: types.Float64Unknown(),
+			},
+			want: &stripe.PriceParams{
+				Active:        stripe.Bool(true),
+				BillingScheme: stripe.String("per_unit"),
+				Currency:      stripe.String("usd"),
+				LookupKey:     stripe.String("standard_monthly"),
+				Metadata:      map[string]string{"plan": "standard"},
+				Nickname:      stripe.String("Standard monthly"),
+				Product:       stripe.String("prod_123"),
+				TaxBehavior:   stripe.String("inclusive"),
+				TransformQuantity: &stripe.PriceTransformQuantityParams{
+					DivideBy: stripe.Int64(10),
+					Round:    stripe.String("up"),
+				},
+				UnitAmount: stripe.Int64(1500),
+			},
+		},
+		{
+			name: "Decimal unit amount",
+			data: PriceResourceModel{
+				Currency:          types.StringValue("usd"),
+				Product:           types.StringValue("prod_123"),
+				UnitAmount:        types.Int64Unknown(),
+				UnitAmountDecimal: types.Float64Value(12.345),
+			},
+			want: &stripe.PriceParams{
+				Currency:          stripe.String("usd"),
+				Product:           stripe.String("prod_123"),
+				UnitAmountDecimal: stripe.Float64(12.345),
+			},
+		},
+		{
+			name: "Graduated tiers",
+			data: PriceResourceModel{
+				BillingScheme: types.StringValue("tiered"),
+				Currency:      types.StringValue("usd"),
+				Product:       types.StringValue("prod_123"),
+				Recurring: types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+					"interval":        types.StringValue("month"),
+					"aggregate_usage": types.StringValue("sum"),
+					"interval_count":  types.Int64Value(3),
+					"meter":           types.StringNull(),
+					"usage_type":      types.StringValue("licensed"),
+				}),
+				TaxBehavior: types.StringValue("exclusive"),
+				Tiers: testListValue(t, types.ObjectType{AttrTypes: PriceTierModel{}.Types()}, []PriceTierModel{
+					{
+						FlatAmount:        types.Int64Value(500),
+						FlatAmountDecimal: types.StringNull(),
+						UnitAmount:        types.Int64Value(1000),
+						UnitAmountDecimal: types.StringNull(),
+						UpTo:              types.Int64Value(5),
+					},
+					{
+						FlatAmount:        types.Int64Null(),
+						FlatAmountDecimal: types.StringNull(),
+						UnitAmount:        types.Int64Null(),
+						UnitAmountDecimal: types.StringValue("750.5"),
+						UpTo:              types.Int64Value(10),
+					},
+					{
+						FlatAmount:        types.Int64Null(),
+						FlatAmountDecimal: types.StringNull(),
+						UnitAmount:        types.Int64Value(500),
+						UnitAmountDecimal: types.StringNull(),
+						UpTo:              types.Int64Null(),
+					},
+				}),
+				TiersMode: types.StringValue("graduated"),
+			},
+			want: &stripe.PriceParams{
+				BillingScheme: stripe.String("tiered"),
+				Currency:      stripe.String("usd"),
+				Product:       stripe.String("prod_123"),
+				Recurring: &stripe.PriceRecurringParams{
+					Interval:      stripe.String("month"),
+					IntervalCount: stripe.Int64(3),
+					UsageType:     stripe.String("licensed"),
+				},
+				TaxBehavior: stripe.String("exclusive"),
+				Tiers: []*stripe.PriceTierParams{
+					{FlatAmount: stripe.Int64(500), UnitAmount: stripe.Int64(1000), UpTo: stripe.Int64(5)},
+					{UnitAmountDecimal: stripe.Float64(750.5), UpTo: stripe.Int64(10)},
+					{UnitAmount: stripe.Int64(500), UpToInf: stripe.Bool(true)},
+				},
+				TiersMode: stripe.String("graduated"),
+			},
+		},
+		{
+			name: "Metered usage",
+			data: PriceResourceModel{
+				Currency: types.StringValue("usd"),
+				Product:  types.StringValue("prod_123"),
+				Recurring: types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+					"interval":        types.StringValue("month"),
+					"aggregate_usage": types.StringValue("max"),
+					"interval_count":  types.Int64Null(),
+					"meter":           types.StringValue("mtr_123"),
+					"usage_type":      types.StringValue("metered"),
+				}),
+			},
+			want: &stripe.PriceParams{
+				Currency: stripe.String("usd"),
+				Product:  stripe.String("prod_123"),
+				Recurring: &stripe.PriceRecurringParams{
+					AggregateUsage: stripe.String("max"),
+					Interval:       stripe.String("month"),
+					Meter:          stripe.String("mtr_123"),
+					UsageType:      stripe.String("metered"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &PriceResource{}
+			diags := diag.Diagnostics{}
+			params := pr.buildCreateParams(context.Background(), tc.data, &diags)
+
+			if !assert.Equal(t, tc.want.Active, params.Active) {
+				t.Errorf("unexpected result for Active: %v", params.Active)
+			}
+			if !assert.Equal(t, tc.want.BillingScheme, params.BillingScheme) {
+				t.Errorf("unexpected result for BillingScheme: %v", params.BillingScheme)
+			}
+			if !assert.Equal(t, tc.want.Currency, params.Currency) {
+				t.Errorf("unexpected result for Currency: %v", params.Currency)
+			}
+			if !assert.Equal(t, tc.want.CurrencyOptions, params.CurrencyOptions) {
+				t.Errorf("unexpected result for CurrencyOptions: %v", params.CurrencyOptions)
+			}
+			if !assert.Equal(t, tc.want.CustomUnitAmount, params.CustomUnitAmount) {
+				t.Errorf("unexpected result for CustomUnitAmount: %v", params.CustomUnitAmount)
+			}
+			if !assert.Equal(t, tc.want.LookupKey, params.LookupKey) {
+				t.Errorf("unexpected result for LookupKey: %v", params.LookupKey)
+			}
+			if !assert.Equal(t, tc.want.Metadata, params.Metadata) {
+				t.Errorf("unexpected result for Metadata: %v", params.Metadata)
+			}
+			if !assert.Equal(t, tc.want.Nickname, params.Nickname) {
+				t.Errorf("unexpected result for Nickname: %v", params.Nickname)
+			}
+			if !assert.Equal(t, tc.want.Product, params.Product) {
+				t.Errorf("unexpected result for Product: %v", params.Product)
+			}
+			if !assert.Equal(t, tc.want.Recurring, params.Recurring) {
+				t.Errorf("unexpected result for Recurring: %v", params.Recurring)
+			}
+			if !assert.Equal(t, tc.want.TaxBehavior, params.TaxBehavior) {
+				t.Errorf("unexpected result for TaxBehavior: %v", params.TaxBehavior)
+			}
+			if !assert.Equal(t, tc.want.Tiers, params.Tiers) {
+				t.Errorf("unexpected result for Tiers: %v", params.Tiers)
+			}
+			if !assert.Equal(t, tc.want.TiersMode, params.TiersMode) {
+				t.Errorf("unexpected result for TiersMode: %v", params.TiersMode)
+			}
+			if !assert.Equal(t, tc.want.TransformQuantity, params.TransformQuantity) {
+				t.Errorf("unexpected result for TransformQuantity: %v", params.TransformQuantity)
+			}
+			if !assert.Equal(t, tc.want.UnitAmount, params.UnitAmount) {
+				t.Errorf("unexpected result for UnitAmount: %v", params.UnitAmount)
+			}
+			if !assert.Equal(t, tc.want.UnitAmountDecimal, params.UnitAmountDecimal) {
+				t.Errorf("unexpected result for UnitAmountDecimal: %v", params.UnitAmountDecimal)
+			}
+		})
+	}
+}
+
+func TestModifyPlanPriceResourceDefaultTaxBehavior(t *testing.T) {
+	currencyOptions := types.MapValueMust(
+		types.ObjectType{
+			AttrTypes: PriceCurrencyOptions{}.Types(),
+		},
+		map[string]attr.Value{
+			"usd": types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+				"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+				"tax_behavior":        types.StringValue("inclusive"),
+				"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+				"unit_amount":         types.Int64Value(1000),
+				"unit_amount_decimal": types.Float64Null(),
+				"top_level":           types.BoolValue(true),
+			}),
+		},
+	)
+
+	tests := []struct {
+		name               string
+		defaultTaxBehavior string
+		taxBehavior        types.String
+		currencyOptions    types.Map
+		want               types.String
+	}{
+		{
+			name:               "provider default",
+			defaultTaxBehavior: "exclusive",
+			taxBehavior:        types.StringUnknown(),
+			currencyOptions:    types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+			want:               types.StringValue("exclusive"),
+		},
+		{
+			name:            "no provider default",
+			taxBehavior:     types.StringUnknown(),
+			currencyOptions: types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+			want:            types.StringValue("unspecified"),
+		},
+		{
+			name:               "configured",
+			defaultTaxBehavior: "exclusive",
+			taxBehavior:        types.StringValue("inclusive"),
+			currencyOptions:    types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+			want:               types.StringValue("inclusive"),
+		},
+		{
+			name:               "currency options",
+			defaultTaxBehavior: "exclusive",
+			taxBehavior:        types.StringUnknown(),
+			currencyOptions:    currencyOptions,
+			want:               types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{
+				providerData: &StripeProviderData{DefaultTaxBehavior: tt.defaultTaxBehavior},
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"currency_options": tt.currencyOptions,
+				"product":          types.StringValue("prod_123"),
+				"tax_behavior":     tt.taxBehavior,
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+				State:  testState(t, r, nil),
+				Plan:   plan,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var taxBehavior types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("tax_behavior"), &taxBehavior)
+			assert.Equal(t, tt.want, taxBehavior)
+		})
+	}
+}
+
+func TestModifyPlanPriceResourceCurrencyOptionTaxBehavior(t *testing.T) {
+	currencyOption := func(taxBehavior types.String, unitAmount int64, topLevel bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        taxBehavior,
+			"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+			"unit_amount":         types.Int64Value(unitAmount),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           types.BoolValue(topLevel),
+		})
+	}
+	currencyOptions := func(usdTaxBehavior, eurTaxBehavior types.String) types.Map {
+		return types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, map[string]attr.Value{
+			"usd": currencyOption(usdTaxBehavior, 1000, true),
+			"eur": currencyOption(eurTaxBehavior, 900, false),
+		})
+	}
+
+	tests := []struct {
+		name      string
+		configUSD types.String
+		configEUR types.String
+		state     types.Map
+		wantUSD   types.String
+		wantEUR   types.String
+	}{
+		{
+			name:      "new price",
+			configUSD: types.StringNull(),
+			configEUR: types.StringNull(),
+			state:     types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+			wantUSD:   types.StringValue("exclusive"),
+			wantEUR:   types.StringValue("exclusive"),
+		},
+		{
+			name:      "configured",
+			configUSD: types.StringValue("inclusive"),
+			configEUR: types.StringNull(),
+			state:     types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+			wantUSD:   types.StringValue("inclusive"),
+			wantEUR:   types.StringValue("exclusive"),
+		},
+		{
+			name:      "existing price",
+			configUSD: types.StringNull(),
+			configEUR: types.StringNull(),
+			state: types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, map[string]attr.Value{
+				"usd": currencyOption(types.StringValue("unspecified"), 1000, true),
+			}),
+			wantUSD: types.StringValue("unspecified"),
+			wantEUR: types.StringValue("exclusive"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{
+				providerData: &StripeProviderData{DefaultTaxBehavior: "exclusive"},
+			}
+			config := testPlan(t, r, map[string]interface{}{
+				"currency_options": currencyOptions(tt.configUSD, tt.configEUR),
+				"product":          types.StringValue("prod_123"),
+			})
+			// The framework fills in the attribute default before ModifyPlan.
+			withDefault := func(taxBehavior types.String) types.String {
+				if taxBehavior.IsNull() {
+					return types.StringValue("unspecified")
+				}
+				return taxBehavior
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"currency_options": currencyOptions(withDefault(tt.configUSD), withDefault(tt.configEUR)),
+				"product":          types.StringValue("prod_123"),
+				"tax_behavior":     types.StringUnknown(),
+			})
+			state := testState(t, r, nil)
+			if !tt.state.IsNull() {
+				state = testState(t, r, map[string]interface{}{
+					"id":               types.StringValue("price_123"),
+					"currency_options": tt.state,
+					"product":          types.StringValue("prod_123"),
+					"tax_behavior":     types.StringValue("unspecified"),
+				})
+			}
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				State:  state,
+				Plan:   plan,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var usd, eur types.String
+			resp.Plan.GetAttribute(context.Background(), path.Root("currency_options").AtMapKey("usd").AtName("tax_behavior"), &usd)
+			resp.Plan.GetAttribute(context.Background(), path.Root("currency_options").AtMapKey("eur").AtName("tax_behavior"), &eur)
+			assert.Equal(t, tt.wantUSD, usd)
+			assert.Equal(t, tt.wantEUR, eur)
+		})
+	}
+}
+
+func TestModifyPlanPriceResourceValidateAgainstAPI(t *testing.T) {
+	tests := []struct {
+		name          string
+		stateCurrency types.String
+		planCurrency  types.String
+		expectError   bool
+		expectCalls   int
+	}{
+		{"supported currency", types.StringNull(), types.StringValue("eur"), false, 2},
+		{"unsupported currency", types.StringNull(), types.StringValue("jpy"), true, 2},
+		{"unchanged currency", types.StringValue("jpy"), types.StringValue("jpy"), false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			sc := testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				switch req.URL.Path {
+				case "/v1/account":
+					_, _ = w.Write([]byte(`{"id": "acct_123", "object": "account", "country": "US"}`))
+				case "/v1/country_specs/US":
+					_, _ = w.Write([]byte(`{"id": "US", "object": "country_spec", "supported_payment_currencies": ["eur", "usd"]}`))
+				default:
+					t.Errorf("unexpected request to %s", req.URL.Path)
+				}
+			})
+			r := &PriceResource{
+				sc:           sc,
+				providerData: &StripeProviderData{Client: sc, ValidateAgainstAPI: true},
+			}
+			state := testState(t, r, nil)
+			if !tt.stateCurrency.IsNull() {
+				state = testState(t, r, map[string]interface{}{
+					"id":       types.StringValue("price_123"),
+					"currency": tt.stateCurrency,
+					"product":  types.StringValue("prod_123"),
+				})
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"currency":     tt.planCurrency,
+				"product":      types.StringValue("prod_123"),
+				"tax_behavior": types.StringValue("exclusive"),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+				State:  state,
+				Plan:   plan,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.expectCalls, calls)
+		})
+	}
+}
+
+func TestCreatePriceResourceCreateIfMissing(t *testing.T) {
+	existing := `{"id":"price_existing","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","lookup_key":"standard","nickname":"Standard","product":"prod_123","tax_behavior":"unspecified","unit_amount":1000,"unit_amount_decimal":"1000"}`
+	tests := []struct {
+		name            string
+		createIfMissing types.Bool
+		existing        string
+		wantCreate      bool
+		wantUpdate      url.Values
+		wantId          string
+		wantErr         bool
+	}{
+		{
+			name:            "adopt existing",
+			createIfMissing: types.BoolValue(true),
+			existing:        existing,
+			wantUpdate:      url.Values{"expand[0]": {"currency_options"}, "expand[1]": {"tiers"}},
+			wantId:          "price_existing",
+		},
+		{
+			name:            "adopt existing with other mutable fields",
+			createIfMissing: types.BoolValue(true),
+			existing:        `{"id":"price_existing","object":"price","active":false,"billing_scheme":"per_unit","currency":"usd","lookup_key":"standard","metadata":{"old":"value"},"nickname":"Old","product":"prod_123","tax_behavior":"unspecified","unit_amount":1000,"unit_amount_decimal":"1000"}`,
+			wantUpdate: url.Values{
+				"active":        {"true"},
+				"expand[0]":     {"currency_options"},
+				"expand[1]":     {"tiers"},
+				"metadata[old]": {""},
+				"nickname":      {"Standard"},
+			},
+			wantId: "price_existing",
+		},
+		{
+			name:            "adopt existing with other amount",
+			createIfMissing: types.BoolValue(true),
+			existing:        `{"id":"price_existing","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","lookup_key":"standard","product":"prod_123","tax_behavior":"unspecified","unit_amount":900,"unit_amount_decimal":"900"}`,
+			wantErr:         true,
+		},
+		{
+			name:            "create missing",
+			createIfMissing: types.BoolValue(true),
+			wantCreate:      true,
+			wantId:          "price_new",
+		},
+		{
+			name:            "create without lookup",
+			createIfMissing: types.BoolNull(),
+			existing:        existing,
+			wantCreate:      true,
+			wantId:          "price_new",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			var update url.Values
+			r := &PriceResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					switch {
+					case req.Method == http.MethodGet:
+						_, _ = w.Write([]byte(`{"object":"list","url":"/v1/prices","has_more":false,"data":[` + tt.existing + `]}`))
+					case req.URL.Path == "/v1/prices":
+						created = true
+						_, _ = w.Write([]byte(`{"id":"price_new","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","lookup_key":"standard","nickname":"Standard","product":"prod_123","tax_behavior":"unspecified","unit_amount":1000,"unit_amount_decimal":"1000"}`))
+					default:
+						require.NoError(t, req.ParseForm())
+						update = req.PostForm
+						_, _ = w.Write([]byte(`{"id":"price_existing","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","lookup_key":"standard","nickname":"Standard","product":"prod_123","tax_behavior":"unspecified","unit_amount":1000,"unit_amount_decimal":"1000"}`))
+					}
+				}),
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"active":            types.BoolValue(true),
+				"billing_scheme":    types.StringValue("per_unit"),
+				"create_if_missing": tt.createIfMissing,
+				"currency":          types.StringValue("usd"),
+				"lookup_key":        types.StringValue("standard"),
+				"nickname":          types.StringValue("Standard"),
+				"product":           types.StringValue("prod_123"),
+				"tax_behavior":      types.StringValue("unspecified"),
+				"unit_amount":       types.Int64Value(1000),
+			})
+			resp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+			assert.Equal(t, tt.wantCreate, created)
+			assert.Equal(t, tt.wantUpdate, update)
+			if tt.wantErr {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "unit_amount")
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var id, nickname types.String
+			resp.State.GetAttribute(context.Background(), path.Root("id"), &id)
+			resp.State.GetAttribute(context.Background(), path.Root("nickname"), &nickname)
+			assert.Equal(t, types.StringValue(tt.wantId), id)
+			assert.Equal(t, types.StringValue("Standard"), nickname)
+		})
+	}
+}
+
+const testAccPriceResourceConfigTiered string = `
+resource "stripe_product" "test" {
+  name = "test_tiered"
+}
+
+resource "stripe_price" "test" {
+  product        = stripe_product.test.id
+  currency       = "usd"
+  billing_scheme = "tiered"
+  tiers_mode     = "graduated"
+  recurring = {
+    interval = "month"
+  }
+  tiers = [
+    {
+      unit_amount = 1000
+      up_to       = 10
+    },
+    {
+      flat_amount = 500
+      unit_amount = 800
+      up_to       = 100
+    },
+    {
+      unit_amount_decimal = "650.5"
+    },
+  ]
+}
+`
+
+func TestAccPriceResourceTiered(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a graduated tiered monthly price
+			{
+				Config: testAccPriceResourceConfigTiered,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_price.test", "billing_scheme", "tiered"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers_mode", "graduated"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.#", "3"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.0.unit_amount", "1000"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.0.up_to", "10"),
+					resource.TestCheckNoResourceAttr("stripe_price.test", "tiers.0.flat_amount"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.1.flat_amount", "500"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.1.unit_amount", "800"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.1.up_to", "100"),
+					resource.TestCheckResourceAttr("stripe_price.test", "tiers.2.unit_amount_decimal", "650.5"),
+					resource.TestCheckNoResourceAttr("stripe_price.test", "tiers.2.up_to"),
+				),
+			},
+			// Read the tiers back without changes
+			{
+				Config: testAccPriceResourceConfigTiered,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestConfigValidatorsPriceResource(t *testing.T) {
+	tier := func(upTo types.Int64) PriceTierModel {
+		return PriceTierModel{
+			FlatAmount:        types.Int64Null(),
+			FlatAmountDecimal: types.StringNull(),
+			UnitAmount:        types.Int64Value(1000),
+			UnitAmountDecimal: types.StringNull(),
+			UpTo:              upTo,
+		}
+	}
+	tiers := func(tiers ...PriceTierModel) types.List {
+		return testListValue(t, types.ObjectType{AttrTypes: PriceTierModel{}.Types()}, tiers)
+	}
+	currencyOption := func(topLevel types.Bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringNull(),
+			"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+			"unit_amount":         types.Int64Value(1000),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           topLevel,
+		})
+	}
+	tieredCurrencyOption := func(topLevel types.Bool, optionTiers types.List) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringNull(),
+			"tiers":               optionTiers,
+			"unit_amount":         types.Int64Null(),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           topLevel,
+		})
+	}
+	currencyOptions := func(elements map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, elements)
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		wantErr    bool
+		wantPath   path.Path
+	}{
+		{
+			name: "per unit",
+			attributes: map[string]interface{}{
+				"unit_amount": types.Int64Value(1000),
+			},
+		},
+		{
+			name: "tiered",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"tiers":          tiers(tier(types.Int64Value(10)), tier(types.Int64Null())),
+				"tiers_mode":     types.StringValue("graduated"),
+			},
+		},
+		{
+			name: "tiered without tiers",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"tiers_mode":     types.StringValue("volume"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers"),
+		},
+		{
+			name: "tiered without tiers mode",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"tiers":          tiers(tier(types.Int64Null())),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers_mode"),
+		},
+		{
+			name: "tiers without tiered billing scheme",
+			attributes: map[string]interface{}{
+				"tiers": tiers(tier(types.Int64Null())),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers"),
+		},
+		{
+			name: "tiers mode without tiered billing scheme",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("per_unit"),
+				"tiers_mode":     types.StringValue("volume"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers_mode"),
+		},
+		{
+			name: "final tier with up to",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"tiers":          tiers(tier(types.Int64Value(10)), tier(types.Int64Value(20))),
+				"tiers_mode":     types.StringValue("graduated"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers").AtListIndex(1).AtName("up_to"),
+		},
+		{
+			name: "other tier without up to",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"tiers":          tiers(tier(types.Int64Null()), tier(types.Int64Null())),
+				"tiers_mode":     types.StringValue("graduated"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("tiers").AtListIndex(0).AtName("up_to"),
+		}, {
+			name: "currency options with top level",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(types.BoolValue(true)),
+					"eur": currencyOption(types.BoolNull()),
+				}),
+			},
+		},
+		{
+			name: "currency options without top level",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(types.BoolNull()),
+					"eur": currencyOption(types.BoolValue(false)),
+				}),
+			},
+			wantErr:  true,
+			wantPath: path.Root("currency_options"),
+		},
+		{
+			name: "tiered currency options",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": tieredCurrencyOption(types.BoolValue(true), types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()})),
+					"eur": tieredCurrencyOption(types.BoolNull(), tiers(tier(types.Int64Value(10)), tier(types.Int64Null()))),
+				}),
+				"tiers":      tiers(tier(types.Int64Value(10)), tier(types.Int64Null())),
+				"tiers_mode": types.StringValue("graduated"),
+			},
+		},
+		{
+			name: "top-level currency option with tiers",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": tieredCurrencyOption(types.BoolValue(true), tiers(tier(types.Int64Null()))),
+				}),
+				"tiers":      tiers(tier(types.Int64Null())),
+				"tiers_mode": types.StringValue("graduated"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("currency_options").AtMapKey("usd").AtName("tiers"),
+		},
+		{
+			name: "currency option tiers without tiered billing scheme",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(types.BoolValue(true)),
+					"eur": tieredCurrencyOption(types.BoolNull(), tiers(tier(types.Int64Null()))),
+				}),
+			},
+			wantErr:  true,
+			wantPath: path.Root("currency_options").AtMapKey("eur").AtName("tiers"),
+		},
+		{
+			name: "currency option tiers without final tier",
+			attributes: map[string]interface{}{
+				"billing_scheme": types.StringValue("tiered"),
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": tieredCurrencyOption(types.BoolValue(true), types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()})),
+					"eur": tieredCurrencyOption(types.BoolNull(), tiers(tier(types.Int64Value(10)), tier(types.Int64Value(20)))),
+				}),
+				"tiers":      tiers(tier(types.Int64Null())),
+				"tiers_mode": types.StringValue("graduated"),
+			},
+			wantErr:  true,
+			wantPath: path.Root("currency_options").AtMapKey("eur").AtName("tiers").AtListIndex(1).AtName("up_to"),
+		},
+		{
+			name: "currency options with several top levels",
+			attributes: map[string]interface{}{
+				"currency_options": currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(types.BoolValue(true)),
+					"eur": currencyOption(types.BoolValue(true)),
+				}),
+			},
+			wantErr:  true,
+			wantPath: path.Root("currency_options"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			plan := testPlan(t, r, tt.attributes)
+			req := fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+			}
+			resp := &fwresource.ValidateConfigResponse{}
+			for _, v := range r.ConfigValidators(context.Background()) {
+				v.ValidateResource(context.Background(), req, resp)
+			}
+
+			if !tt.wantErr {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+			withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			require.True(t, ok, resp.Diagnostics)
+			assert.Equal(t, tt.wantPath, withPath.Path())
+		})
+	}
+}
+
 //func TestAccPriceResource(t *testing.T) {
 //	resource.Test(t, resource.TestCase{
 //		PreCheck:                 func() { testAccPreCheck(t) },
@@ -84,3 +1492,876 @@ package provider
 //}
 //`
 //)
+
+func TestCreatePriceResourceOneTime(t *testing.T) {
+	var form url.Values
+	response := `{"id":"price_123","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","product":"prod_123","recurring":null,"tax_behavior":"unspecified","type":"one_time","unit_amount":1000}`
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPost {
+				require.NoError(t, req.ParseForm())
+				form = req.PostForm
+			}
+			_, _ = w.Write([]byte(response))
+		}),
+	}
+	plan := testPlan(t, r, map[string]interface{}{
+		"active":         types.BoolValue(true),
+		"billing_scheme": types.StringValue("per_unit"),
+		"currency":       types.StringValue("usd"),
+		"product":        types.StringValue("prod_123"),
+		"tax_behavior":   types.StringValue("unspecified"),
+		"unit_amount":    types.Int64Value(1000),
+	})
+	createResp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	for key := range form {
+		assert.NotContains(t, key, "recurring")
+	}
+	var recurring types.Object
+	createResp.State.GetAttribute(context.Background(), path.Root("recurring"), &recurring)
+	assert.Equal(t, types.ObjectNull(PriceRecurring{}.Types()), recurring)
+
+	// Refreshing the one-time price must not change the state.
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.Equal(createResp.State.Raw))
+}
+
+func TestCreatePriceResourceCurrencyOptions(t *testing.T) {
+	var form url.Values
+	response := `{
+		"id": "price_123",
+		"object": "price",
+		"active": true,
+		"billing_scheme": "per_unit",
+		"currency": "usd",
+		"currency_options": {
+			"eur": {"tax_behavior": "exclusive", "unit_amount": 900, "unit_amount_decimal": "900"},
+			"usd": {"tax_behavior": "exclusive", "unit_amount": 1000, "unit_amount_decimal": "1000"}
+		},
+		"product": "prod_123",
+		"tax_behavior": "exclusive",
+		"type": "one_time",
+		"unit_amount": 1000,
+		"unit_amount_decimal": "1000"
+	}`
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPost {
+				require.NoError(t, req.ParseForm())
+				form = req.PostForm
+			}
+			_, _ = w.Write([]byte(response))
+		}),
+	}
+	currencyOption := func(unitAmount int64, topLevel bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringValue("exclusive"),
+			"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+			"unit_amount":         types.Int64Value(unitAmount),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           types.BoolValue(topLevel),
+		})
+	}
+	plan := testPlan(t, r, map[string]interface{}{
+		"active":         types.BoolValue(true),
+		"billing_scheme": types.StringValue("per_unit"),
+		"currency":       types.StringUnknown(),
+		"currency_options": types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, map[string]attr.Value{
+			"usd": currencyOption(1000, true),
+			"eur": currencyOption(900, false),
+		}),
+		"product":             types.StringValue("prod_123"),
+		"tax_behavior":        types.StringUnknown(),
+		"unit_amount":         types.Int64Unknown(),
+		"unit_amount_decimal": types.Float64Unknown(),
+	})
+	createResp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	// The top-level entry is sent as the price itself, the others as
+	// currency options.
+	assert.Equal(t, "usd", form.Get("currency"))
+	assert.Equal(t, "1000", form.Get("unit_amount"))
+	assert.Equal(t, "exclusive", form.Get("tax_behavior"))
+	assert.Equal(t, "900", form.Get("currency_options[eur][unit_amount]"))
+	assert.Equal(t, "exclusive", form.Get("currency_options[eur][tax_behavior]"))
+	assert.Empty(t, form.Get("currency_options[usd][unit_amount]"))
+	assert.Equal(t, "currency_options", form.Get("expand[0]"))
+
+	var currency types.String
+	var unitAmount types.Int64
+	var currencyOptions map[string]PriceCurrencyOptions
+	createResp.Diagnostics.Append(createResp.State.GetAttribute(context.Background(), path.Root("currency"), &currency)...)
+	createResp.Diagnostics.Append(createResp.State.GetAttribute(context.Background(), path.Root("unit_amount"), &unitAmount)...)
+	createResp.Diagnostics.Append(createResp.State.GetAttribute(context.Background(), path.Root("currency_options"), &currencyOptions)...)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, types.StringValue("usd"), currency)
+	assert.Equal(t, types.Int64Value(1000), unitAmount)
+	require.Len(t, currencyOptions, 2)
+	assert.Equal(t, types.Int64Value(1000), currencyOptions["usd"].UnitAmount)
+	assert.Equal(t, types.BoolValue(true), currencyOptions["usd"].TopLevel)
+	assert.Equal(t, types.Int64Value(900), currencyOptions["eur"].UnitAmount)
+	assert.Equal(t, types.BoolValue(false), currencyOptions["eur"].TopLevel)
+	// Stripe returns the decimal amount as well, which was not configured.
+	assert.Equal(t, types.Float64Null(), currencyOptions["usd"].UnitAmountDecimal)
+	assert.Equal(t, types.Float64Null(), currencyOptions["eur"].UnitAmountDecimal)
+
+	// Refreshing the price must not change the state.
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.Equal(createResp.State.Raw))
+}
+
+func TestCreatePriceResourceCurrencyOptionTiers(t *testing.T) {
+	var form url.Values
+	var readExpands []string
+	response := `{
+		"id": "price_123",
+		"object": "price",
+		"active": true,
+		"billing_scheme": "tiered",
+		"currency": "usd",
+		"currency_options": {
+			"eur": {"tax_behavior": "unspecified", "tiers": [
+				{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 900, "unit_amount_decimal": "900", "up_to": 10},
+				{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 800, "unit_amount_decimal": "800", "up_to": null}
+			]},
+			"usd": {"tax_behavior": "unspecified"}
+		},
+		"product": "prod_123",
+		"tax_behavior": "unspecified",
+		"tiers": [
+			{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 1000, "unit_amount_decimal": "1000", "up_to": 10},
+			{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 900, "unit_amount_decimal": "900", "up_to": null}
+		],
+		"tiers_mode": "graduated",
+		"type": "one_time"
+	}`
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			if req.Method == http.MethodPost {
+				require.NoError(t, req.ParseForm())
+				form = req.PostForm
+			} else {
+				readExpands = nil
+				for key, values := range req.URL.Query() {
+					if strings.HasPrefix(key, "expand") {
+						readExpands = append(readExpands, values...)
+					}
+				}
+			}
+			_, _ = w.Write([]byte(response))
+		}),
+	}
+	tierType := types.ObjectType{AttrTypes: PriceTierModel{}.Types()}
+	tiers := func(first, final int64) types.List {
+		return types.ListValueMust(tierType, []attr.Value{
+			types.ObjectValueMust(PriceTierModel{}.Types(), map[string]attr.Value{
+				"flat_amount":         types.Int64Null(),
+				"flat_amount_decimal": types.StringNull(),
+				"unit_amount":         types.Int64Value(first),
+				"unit_amount_decimal": types.StringNull(),
+				"up_to":               types.Int64Value(10),
+			}),
+			types.ObjectValueMust(PriceTierModel{}.Types(), map[string]attr.Value{
+				"flat_amount":         types.Int64Null(),
+				"flat_amount_decimal": types.StringNull(),
+				"unit_amount":         types.Int64Value(final),
+				"unit_amount_decimal": types.StringNull(),
+				"up_to":               types.Int64Null(),
+			}),
+		})
+	}
+	currencyOption := func(optionTiers types.List, topLevel bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringValue("unspecified"),
+			"tiers":               optionTiers,
+			"unit_amount":         types.Int64Null(),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           types.BoolValue(topLevel),
+		})
+	}
+	plan := testPlan(t, r, map[string]interface{}{
+		"active":         types.BoolValue(true),
+		"billing_scheme": types.StringValue("tiered"),
+		"currency":       types.StringUnknown(),
+		"currency_options": types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, map[string]attr.Value{
+			"usd": currencyOption(types.ListNull(tierType), true),
+			"eur": currencyOption(tiers(900, 800), false),
+		}),
+		"product":             types.StringValue("prod_123"),
+		"tax_behavior":        types.StringUnknown(),
+		"tiers":               tiers(1000, 900),
+		"tiers_mode":          types.StringValue("graduated"),
+		"unit_amount":         types.Int64Unknown(),
+		"unit_amount_decimal": types.Float64Unknown(),
+	})
+	createResp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	assert.Equal(t, "900", form.Get("currency_options[eur][tiers][0][unit_amount]"))
+	assert.Equal(t, "10", form.Get("currency_options[eur][tiers][0][up_to]"))
+	assert.Equal(t, "800", form.Get("currency_options[eur][tiers][1][unit_amount]"))
+	assert.Equal(t, "inf", form.Get("currency_options[eur][tiers][1][up_to]"))
+	assert.Equal(t, "currency_options.eur.tiers", form.Get("expand[2]"))
+
+	var currencyOptions map[string]PriceCurrencyOptions
+	createResp.Diagnostics.Append(createResp.State.GetAttribute(context.Background(), path.Root("currency_options"), &currencyOptions)...)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+	assert.Equal(t, tiers(900, 800), currencyOptions["eur"].Tiers)
+	assert.Equal(t, types.ListNull(tierType), currencyOptions["usd"].Tiers)
+
+	// Refreshing the price expands the tiers again and must not change the
+	// state.
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(context.Background(), fwresource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.Contains(t, readExpands, "currency_options.eur.tiers")
+	assert.True(t, readResp.State.Raw.Equal(createResp.State.Raw))
+}
+
+func TestImportStatePriceResourceCurrencyOptions(t *testing.T) {
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "currency_options", req.URL.Query().Get("expand[0]"))
+			_, _ = w.Write([]byte(`{
+				"id": "price_123",
+				"object": "price",
+				"active": true,
+				"billing_scheme": "per_unit",
+				"created": 1700000000,
+				"currency": "usd",
+				"currency_options": {
+					"eur": {"tax_behavior": "exclusive", "unit_amount": 900, "unit_amount_decimal": "900"},
+					"usd": {"tax_behavior": "exclusive", "unit_amount": 1000, "unit_amount_decimal": "1000"}
+				},
+				"product": "prod_123",
+				"tax_behavior": "exclusive",
+				"type": "one_time",
+				"unit_amount": 1000,
+				"unit_amount_decimal": "1000"
+			}`))
+		}),
+	}
+	resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "price_123"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var currencyOptions map[string]PriceCurrencyOptions
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("currency_options"), &currencyOptions)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.Len(t, currencyOptions, 2)
+	assert.Equal(t, types.Int64Value(900), currencyOptions["eur"].UnitAmount)
+	assert.Equal(t, types.BoolValue(false), currencyOptions["eur"].TopLevel)
+	assert.Equal(t, types.Int64Value(1000), currencyOptions["usd"].UnitAmount)
+	assert.Equal(t, types.BoolValue(true), currencyOptions["usd"].TopLevel)
+	assert.Equal(t, types.Float64Null(), currencyOptions["eur"].UnitAmountDecimal)
+	assert.Equal(t, types.Float64Null(), currencyOptions["usd"].UnitAmountDecimal)
+}
+
+func TestImportStatePriceResourceTiers(t *testing.T) {
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "tiers", req.URL.Query().Get("expand[1]"))
+			_, _ = w.Write([]byte(`{
+				"id": "price_123",
+				"object": "price",
+				"active": true,
+				"billing_scheme": "tiered",
+				"created": 1700000000,
+				"currency": "usd",
+				"product": "prod_123",
+				"recurring": {"interval": "month", "interval_count": 1, "usage_type": "licensed"},
+				"tax_behavior": "exclusive",
+				"tiers": [
+					{"flat_amount": 1000, "flat_amount_decimal": "1000", "unit_amount": null, "unit_amount_decimal": null, "up_to": 10},
+					{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 80, "unit_amount_decimal": "80", "up_to": 100},
+					{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 0, "unit_amount_decimal": "0.5", "up_to": null}
+				],
+				"tiers_mode": "graduated",
+				"type": "recurring"
+			}`))
+		}),
+	}
+	resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "price_123"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var tiers []PriceTierModel
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("tiers"), &tiers)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal(t, []PriceTierModel{
+		{
+			FlatAmount:        types.Int64Value(1000),
+			FlatAmountDecimal: types.StringNull(),
+			UnitAmount:        types.Int64Null(),
+			UnitAmountDecimal: types.StringNull(),
+			UpTo:              types.Int64Value(10),
+		},
+		{
+			FlatAmount:        types.Int64Null(),
+			FlatAmountDecimal: types.StringNull(),
+			UnitAmount:        types.Int64Value(80),
+			UnitAmountDecimal: types.StringNull(),
+			UpTo:              types.Int64Value(100),
+		},
+		{
+			FlatAmount:        types.Int64Null(),
+			FlatAmountDecimal: types.StringNull(),
+			UnitAmount:        types.Int64Null(),
+			UnitAmountDecimal: types.StringValue("0.5"),
+			UpTo:              types.Int64Null(),
+		},
+	}, tiers)
+}
+
+func TestImportStatePriceResourceIntervalCount(t *testing.T) {
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(`{
+				"id": "price_123",
+				"object": "price",
+				"active": true,
+				"billing_scheme": "per_unit",
+				"created": 1700000000,
+				"currency": "usd",
+				"product": "prod_123",
+				"recurring": {"interval": "month", "interval_count": 1, "usage_type": "licensed"},
+				"tax_behavior": "exclusive",
+				"type": "recurring",
+				"unit_amount": 1000,
+				"unit_amount_decimal": "1000"
+			}`))
+		}),
+	}
+	resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "price_123"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var imported types.Object
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("recurring"), &imported)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// Both a configured interval count of 1 and an unset one, which takes the
+	// default, plan the imported price without changes.
+	planned := types.ObjectValueMust(PriceRecurring{}.Types(), map[string]attr.Value{
+		"interval":        types.StringValue("month"),
+		"aggregate_usage": types.StringValue("sum"),
+		"interval_count":  types.Int64Value(1),
+		"meter":           types.StringNull(),
+		"usage_type":      types.StringValue("licensed"),
+	})
+	assert.Equal(t, planned, imported)
+
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	recurring := schemaResp.Schema.Attributes["recurring"].(schema.SingleNestedAttribute)
+	req := planmodifier.ObjectRequest{
+		Path:        path.Root("recurring"),
+		ConfigValue: planned,
+		PlanValue:   planned,
+		StateValue:  imported,
+		Plan:        testPlan(t, r, map[string]interface{}{"recurring": planned}),
+		State:       resp.State,
+	}
+	modifyResp := &planmodifier.ObjectResponse{PlanValue: planned}
+	for _, m := range recurring.ObjectPlanModifiers() {
+		m.PlanModifyObject(ctx, req, modifyResp)
+	}
+	require.False(t, modifyResp.Diagnostics.HasError(), modifyResp.Diagnostics)
+	assert.False(t, modifyResp.RequiresReplace)
+}
+
+func TestImportStatePriceResourceCurrencyOptionTiers(t *testing.T) {
+	var requests int
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			requests++
+			eurTiers := ""
+			// The currency options are only known after the first request.
+			if requests == 2 {
+				assert.Equal(t, "currency_options.eur.tiers", req.URL.Query().Get("expand[2]"))
+				eurTiers = `, "tiers": [{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 900, "unit_amount_decimal": "900", "up_to": null}]`
+			}
+			_, _ = w.Write([]byte(`{
+				"id": "price_123",
+				"object": "price",
+				"active": true,
+				"billing_scheme": "tiered",
+				"currency": "usd",
+				"currency_options": {
+					"eur": {"tax_behavior": "unspecified"` + eurTiers + `},
+					"usd": {"tax_behavior": "unspecified"}
+				},
+				"product": "prod_123",
+				"tax_behavior": "unspecified",
+				"tiers": [{"flat_amount": null, "flat_amount_decimal": null, "unit_amount": 1000, "unit_amount_decimal": "1000", "up_to": null}],
+				"tiers_mode": "volume",
+				"type": "one_time"
+			}`))
+		}),
+	}
+	resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+	r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "price_123"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, 2, requests)
+
+	var currencyOptions map[string]PriceCurrencyOptions
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("currency_options"), &currencyOptions)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var eurTiers []PriceTierModel
+	require.False(t, currencyOptions["eur"].Tiers.ElementsAs(context.Background(), &eurTiers, false).HasError())
+	assert.Equal(t, []PriceTierModel{{
+		FlatAmount:        types.Int64Null(),
+		FlatAmountDecimal: types.StringNull(),
+		UnitAmount:        types.Int64Value(900),
+		UnitAmountDecimal: types.StringNull(),
+		UpTo:              types.Int64Null(),
+	}}, eurTiers)
+	assert.True(t, currencyOptions["usd"].Tiers.IsNull())
+}
+
+func TestPopulateTiersPriceResource(t *testing.T) {
+	tierType := types.ObjectType{AttrTypes: PriceTierModel{}.Types()}
+	tier := func(unitAmount types.Int64, unitAmountDecimal types.String, upTo types.Int64) PriceTierModel {
+		return PriceTierModel{
+			FlatAmount:        types.Int64Null(),
+			FlatAmountDecimal: types.StringNull(),
+			UnitAmount:        unitAmount,
+			UnitAmountDecimal: unitAmountDecimal,
+			UpTo:              upTo,
+		}
+	}
+	graduated := []*stripe.PriceTier{
+		{UnitAmount: 1000, UnitAmountDecimal: 1000, UpTo: 10},
+		{UnitAmountDecimal: 650.5},
+	}
+
+	tests := []struct {
+		name  string
+		prior types.List
+		tiers []*stripe.PriceTier
+		want  types.List
+	}{
+		{"per unit", types.ListNull(tierType), nil, types.ListNull(tierType)},
+		{"imported", types.ListNull(tierType), graduated, testListValue(t, tierType, []PriceTierModel{
+			tier(types.Int64Value(1000), types.StringNull(), types.Int64Value(10)),
+			tier(types.Int64Null(), types.StringValue("650.5"), types.Int64Null()),
+		})},
+		{"configured decimals", testListValue(t, tierType, []PriceTierModel{
+			tier(types.Int64Null(), types.StringValue("1000.00"), types.Int64Value(10)),
+			tier(types.Int64Null(), types.StringValue("650.50"), types.Int64Null()),
+		}), graduated, testListValue(t, tierType, []PriceTierModel{
+			tier(types.Int64Null(), types.StringValue("1000.00"), types.Int64Value(10)),
+			tier(types.Int64Null(), types.StringValue("650.50"), types.Int64Null()),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			diags := diag.Diagnostics{}
+			got := r.populateTiers(context.Background(), tt.prior, tt.tiers, &diags)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPriceTierAmount(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        int64
+		amountDecimal float64
+		prior         types.Int64
+		priorDecimal  types.String
+		want          types.Int64
+		wantDecimal   types.String
+	}{
+		{"not set", 0, 0, types.Int64Null(), types.StringNull(), types.Int64Null(), types.StringNull()},
+		{"integer", 80, 80, types.Int64Null(), types.StringNull(), types.Int64Value(80), types.StringNull()},
+		{"configured zero", 0, 0, types.Int64Value(0), types.StringNull(), types.Int64Value(0), types.StringNull()},
+		{"fractional", 0, 0.5, types.Int64Null(), types.StringNull(), types.Int64Null(), types.StringValue("0.5")},
+		{"configured decimal", 80, 80, types.Int64Null(), types.StringValue("80.00"), types.Int64Null(), types.StringValue("80.00")},
+		{"changed decimal", 90, 90, types.Int64Null(), types.StringValue("80.00"), types.Int64Null(), types.StringValue("90")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotDecimal := priceTierAmount(tt.amount, tt.amountDecimal, tt.prior, tt.priorDecimal)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDecimal, gotDecimal)
+		})
+	}
+}
+
+func TestImportStatePriceResourceLookupKey(t *testing.T) {
+	const price = `{"id": "price_123", "object": "price", "currency": "usd", "lookup_key": "standard_monthly", "product": "prod_123", "tax_behavior": "exclusive", "type": "one_time", "unit_amount": 1000}`
+
+	tests := []struct {
+		name        string
+		id          string
+		response    string
+		wantPath    string
+		wantErr     string
+		wantLookups []string
+	}{
+		{
+			name:     "price id",
+			id:       "price_123",
+			response: price,
+			wantPath: "/v1/prices/price_123",
+		},
+		{
+			name:        "lookup key",
+			id:          "standard_monthly",
+			response:    `{"object": "list", "data": [` + price + `], "has_more": false}`,
+			wantPath:    "/v1/prices",
+			wantLookups: []string{"standard_monthly"},
+		},
+		{
+			name:        "unknown lookup key",
+			id:          "Standard monthly",
+			response:    `{"object": "list", "data": [], "has_more": false}`,
+			wantPath:    "/v1/prices",
+			wantErr:     "Price Not Found",
+			wantLookups: []string{"Standard monthly"},
+		},
+		{
+			name:    "other object id",
+			id:      "prod_123",
+			wantErr: "Invalid Import ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestPath string
+			var lookups []string
+			r := &PriceResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					requestPath = req.URL.Path
+					lookups = req.URL.Query()["lookup_keys[0]"]
+					_, _ = w.Write([]byte(tt.response))
+				}),
+			}
+			resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: tt.id}, resp)
+
+			assert.Equal(t, tt.wantPath, requestPath)
+			assert.Equal(t, tt.wantLookups, lookups)
+			if tt.wantErr != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantErr, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+			assert.Equal(t, types.StringValue("price_123"), id)
+		})
+	}
+}
+
+func TestImportStatePriceResourceUnexpectedObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{"product returned", `{"id": "prod_123", "object": "product", "name": "Product"}`},
+		{"price with wrong prefix", `{"id": "prod_123", "object": "price", "currency": "usd", "product": "prod_123"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{
+				sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+					_, _ = w.Write([]byte(tt.response))
+				}),
+			}
+			resp := &fwresource.ImportStateResponse{State: testState(t, r, nil)}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: "price_123"}, resp)
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestBuildParamsPriceResourceDiagnostics(t *testing.T) {
+	// Values of the wrong type cannot be converted, which must be reported
+	// instead of sending incomplete params.
+	invalid := types.ObjectValueMust(PriceTransformQuantity{}.Types(), map[string]attr.Value{
+		"divide_by": types.Int64Value(10),
+		"round":     types.StringValue("up"),
+	})
+	r := &PriceResource{}
+
+	diags := diag.Diagnostics{}
+	r.buildCreateParams(context.Background(), PriceResourceModel{CustomUnitAmount: invalid}, &diags)
+	assert.True(t, diags.HasError())
+
+	diags = diag.Diagnostics{}
+	r.buildUpdateParams(context.Background(), PriceResourceModel{
+		CurrencyOptions: types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}),
+	}, PriceResourceModel{
+		CurrencyOptions: types.MapValueMust(types.ObjectType{AttrTypes: PriceTransformQuantity{}.Types()}, map[string]attr.Value{
+			"eur": invalid,
+		}),
+	}, &diags)
+	assert.True(t, diags.HasError())
+}
+
+func TestBuildUpdateParamsPriceResource(t *testing.T) {
+	currencyOption := func(unitAmount int64, topLevel bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringValue("exclusive"),
+			"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+			"unit_amount":         types.Int64Value(unitAmount),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           types.BoolValue(topLevel),
+		})
+	}
+	currencyOptions := func(elements map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, elements)
+	}
+	price := func(active bool, lookupKey, nickname types.String, metadata types.Map) PriceResourceModel {
+		return PriceResourceModel{
+			Active:      types.BoolValue(active),
+			Currency:    types.StringValue("usd"),
+			LookupKey:   lookupKey,
+			Metadata:    metadata,
+			Nickname:    nickname,
+			TaxBehavior: types.StringValue("unspecified"),
+			UnitAmount:  types.Int64Value(1000),
+		}
+	}
+	metadata := testMapValue(t, types.StringType, map[string]interface{}{"plan": "standard", "team": "billing"})
+	base := price(true, types.StringValue("standard"), types.StringValue("Standard"), metadata)
+
+	tests := []struct {
+		name  string
+		state PriceResourceModel
+		plan  PriceResourceModel
+		want  *stripe.PriceParams
+	}{
+		{
+			name:  "unchanged",
+			state: base,
+			plan:  base,
+			want:  &stripe.PriceParams{},
+		},
+		{
+			name:  "archive",
+			state: base,
+			plan:  price(false, types.StringValue("standard"), types.StringValue("Standard"), metadata),
+			want:  &stripe.PriceParams{Active: stripe.Bool(false)},
+		},
+		{
+			name:  "re-activate",
+			state: price(false, types.StringValue("standard"), types.StringValue("Standard"), metadata),
+			plan:  base,
+			want:  &stripe.PriceParams{Active: stripe.Bool(true)},
+		},
+		{
+			name:  "change nickname only",
+			state: base,
+			plan:  price(true, types.StringValue("standard"), types.StringValue("Standard monthly"), metadata),
+			want:  &stripe.PriceParams{Nickname: stripe.String("Standard monthly")},
+		},
+		{
+			name:  "remove nickname",
+			state: base,
+			plan:  price(true, types.StringValue("standard"), types.StringNull(), metadata),
+			want:  &stripe.PriceParams{Nickname: stripe.String("")},
+		},
+		{
+			name:  "change lookup key",
+			state: base,
+			plan:  price(true, types.StringValue("standard_v2"), types.StringValue("Standard"), metadata),
+			want:  &stripe.PriceParams{LookupKey: stripe.String("standard_v2")},
+		},
+		{
+			name:  "remove lookup key",
+			state: base,
+			plan:  price(true, types.StringNull(), types.StringValue("Standard"), metadata),
+			want:  &stripe.PriceParams{LookupKey: stripe.String("")},
+		},
+		{
+			name:  "remove metadata key",
+			state: base,
+			plan:  price(true, types.StringValue("standard"), types.StringValue("Standard"), testMapValue(t, types.StringType, map[string]interface{}{"plan": "premium"})),
+			want:  &stripe.PriceParams{Metadata: map[string]string{"plan": "premium", "team": ""}},
+		},
+		{
+			name:  "change tax behavior",
+			state: base,
+			plan: func() PriceResourceModel {
+				plan := base
+				plan.TaxBehavior = types.StringValue("inclusive")
+				return plan
+			}(),
+			want: &stripe.PriceParams{TaxBehavior: stripe.String("inclusive")},
+		},
+		{
+			name: "add and change currency options",
+			state: func() PriceResourceModel {
+				state := base
+				state.CurrencyOptions = currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(1000, true),
+					"eur": currencyOption(900, false),
+					"gbp": currencyOption(800, false),
+				})
+				return state
+			}(),
+			plan: func() PriceResourceModel {
+				plan := base
+				plan.CurrencyOptions = currencyOptions(map[string]attr.Value{
+					"usd": currencyOption(1000, true),
+					"eur": currencyOption(950, false),
+					"gbp": currencyOption(800, false),
+					"jpy": currencyOption(150, false),
+				})
+				return plan
+			}(),
+			want: &stripe.PriceParams{
+				CurrencyOptions: map[string]*stripe.PriceCurrencyOptionsParams{
+					"eur": {TaxBehavior: stripe.String("exclusive"), UnitAmount: stripe.Int64(950)},
+					"jpy": {TaxBehavior: stripe.String("exclusive"), UnitAmount: stripe.Int64(150)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PriceResource{}
+			diags := diag.Diagnostics{}
+			params := r.buildUpdateParams(context.Background(), tt.state, tt.plan, &diags)
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, params)
+		})
+	}
+}
+
+func TestPriceResourceCurrencyOptionsRequiresReplace(t *testing.T) {
+	currencyOption := func(unitAmount int64, topLevel bool) attr.Value {
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), map[string]attr.Value{
+			"custom_unit_amount":  types.ObjectNull(PriceCustomUnitAmount{}.Types()),
+			"tax_behavior":        types.StringValue("exclusive"),
+			"tiers":               types.ListNull(types.ObjectType{AttrTypes: PriceTierModel{}.Types()}),
+			"unit_amount":         types.Int64Value(unitAmount),
+			"unit_amount_decimal": types.Float64Null(),
+			"top_level":           types.BoolValue(topLevel),
+		})
+	}
+	currencyOptions := func(elements map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()}, elements)
+	}
+	withTaxBehavior := func(option attr.Value, taxBehavior types.String) attr.Value {
+		attrs := option.(types.Object).Attributes()
+		attrs["tax_behavior"] = taxBehavior
+		return types.ObjectValueMust(PriceCurrencyOptions{}.Types(), attrs)
+	}
+	state := currencyOptions(map[string]attr.Value{
+		"usd": currencyOption(1000, true),
+		"eur": currencyOption(900, false),
+	})
+	nullCurrencyOptions := types.MapNull(types.ObjectType{AttrTypes: PriceCurrencyOptions{}.Types()})
+	defaultedPlan := currencyOptions(map[string]attr.Value{
+		"usd": withTaxBehavior(currencyOption(1000, true), types.StringValue("unspecified")),
+		"eur": currencyOption(900, false),
+	})
+
+	tests := []struct {
+		name        string
+		plan        types.Map
+		config      types.Map
+		wantReplace bool
+	}{
+		{"unchanged", state, nullCurrencyOptions, false},
+		{"add option", currencyOptions(map[string]attr.Value{"usd": currencyOption(1000, true), "eur": currencyOption(900, false), "gbp": currencyOption(800, false)}), nullCurrencyOptions, false},
+		{"change option", currencyOptions(map[string]attr.Value{"usd": currencyOption(1000, true), "eur": currencyOption(950, false)}), nullCurrencyOptions, false},
+		{"change top-level option", currencyOptions(map[string]attr.Value{"usd": currencyOption(1100, true), "eur": currencyOption(900, false)}), nullCurrencyOptions, true},
+		{"move top level", currencyOptions(map[string]attr.Value{"usd": currencyOption(1000, false), "eur": currencyOption(900, true)}), nullCurrencyOptions, true},
+		{"remove option", currencyOptions(map[string]attr.Value{"usd": currencyOption(1000, true)}), nullCurrencyOptions, true},
+		{"unset top-level tax behavior", defaultedPlan, currencyOptions(map[string]attr.Value{
+			"usd": withTaxBehavior(currencyOption(1000, true), types.StringNull()),
+			"eur": currencyOption(900, false),
+		}), false},
+		{"change top-level tax behavior", defaultedPlan, defaultedPlan, true},
+	}
+
+	ctx := context.Background()
+	r := &PriceResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	attribute := schemaResp.Schema.Attributes["currency_options"].(schema.MapNestedAttribute)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.MapRequest{
+				Path:        path.Root("currency_options"),
+				ConfigValue: tt.config,
+				PlanValue:   tt.plan,
+				StateValue:  state,
+				Plan:        testPlan(t, r, map[string]interface{}{"currency_options": tt.plan}),
+				State:       testState(t, r, map[string]interface{}{"currency_options": state}),
+			}
+			resp := &planmodifier.MapResponse{PlanValue: tt.plan}
+			for _, m := range attribute.MapPlanModifiers() {
+				m.PlanModifyMap(ctx, req, resp)
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.wantReplace, resp.RequiresReplace)
+		})
+	}
+}
+
+func TestUpdatePriceResourceReactivateArchived(t *testing.T) {
+	var form url.Values
+	r := &PriceResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			require.NoError(t, req.ParseForm())
+			form = req.PostForm
+			_, _ = w.Write([]byte(`{"id":"price_123","object":"price","active":true,"billing_scheme":"per_unit","currency":"usd","product":"prod_123","tax_behavior":"unspecified","unit_amount":1000}`))
+		}),
+	}
+	attributes := map[string]interface{}{
+		"id":             types.StringValue("price_123"),
+		"billing_scheme": types.StringValue("per_unit"),
+		"currency":       types.StringValue("usd"),
+		"product":        types.StringValue("prod_123"),
+		"tax_behavior":   types.StringValue("unspecified"),
+		"unit_amount":    types.Int64Value(1000),
+	}
+	// The price was archived outside of Terraform and refreshed into state.
+	attributes["active"] = types.BoolValue(false)
+	state := testState(t, r, attributes)
+	attributes["active"] = types.BoolValue(true)
+	plan := testPlan(t, r, attributes)
+
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(context.Background(), fwresource.UpdateRequest{State: state, Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	assert.Equal(t, "true", form.Get("active"))
+	var active types.Bool
+	resp.State.GetAttribute(context.Background(), path.Root("active"), &active)
+	assert.Equal(t, types.BoolValue(true), active)
+}
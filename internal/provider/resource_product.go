@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,6 +29,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProductResource{}
 var _ resource.ResourceWithImportState = &ProductResource{}
+var _ resource.ResourceWithModifyPlan = &ProductResource{}
+var _ resource.ResourceWithMoveState = &ProductResource{}
+var _ resource.ResourceWithValidateConfig = &ProductResource{}
 
 func NewProductResource() resource.Resource {
 	return &ProductResource{}
@@ -32,20 +39,25 @@ func NewProductResource() resource.Resource {
 
 // ProductResource defines the resource implementation.
 type ProductResource struct {
-	sc *client.API
+	sc           *client.API
+	providerData *StripeProviderData
 }
 
 // ProductResourceModel describes the resource data model.
 type ProductResourceModel struct {
 	Id                  types.String `tfsdk:"id"`
 	Active              types.Bool   `tfsdk:"active"`
+	ConfigHash          types.String `tfsdk:"config_hash"`
 	DefaultPrice        types.String `tfsdk:"default_price"`
+	DefaultPriceData    types.Object `tfsdk:"default_price_data"`
 	Description         types.String `tfsdk:"description"`
 	Images              types.List   `tfsdk:"images"`
 	MarketingFeatures   types.List   `tfsdk:"marketing_features"`
 	Metadata            types.Map    `tfsdk:"metadata"`
+	MetadataKeys        types.List   `tfsdk:"metadata_keys"`
 	Name                types.String `tfsdk:"name"`
 	PackageDimensions   types.Object `tfsdk:"package_dimensions"`
+	RequireLivemode     types.Bool   `tfsdk:"require_livemode"`
 	Shippable           types.Bool   `tfsdk:"shippable"`
 	StatementDescriptor types.String `tfsdk:"statement_descriptor"`
 	TaxCode             types.String `tfsdk:"tax_code"`
@@ -70,6 +82,40 @@ func (m ProductPackageDimensionsResourceModel) Types() map[string]attr.Type {
 	}
 }
 
+// ProductDefaultPriceDataModel describes the inline default price created
+// together with a product.
+type ProductDefaultPriceDataModel struct {
+	Currency          types.String  `tfsdk:"currency"`
+	Recurring         types.Object  `tfsdk:"recurring"`
+	TaxBehavior       types.String  `tfsdk:"tax_behavior"`
+	UnitAmount        types.Int64   `tfsdk:"unit_amount"`
+	UnitAmountDecimal types.Float64 `tfsdk:"unit_amount_decimal"`
+}
+
+func (m ProductDefaultPriceDataModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"currency": types.StringType,
+		"recurring": types.ObjectType{
+			AttrTypes: ProductDefaultPriceDataRecurringModel{}.Types(),
+		},
+		"tax_behavior":        types.StringType,
+		"unit_amount":         types.Int64Type,
+		"unit_amount_decimal": types.Float64Type,
+	}
+}
+
+type ProductDefaultPriceDataRecurringModel struct {
+	Interval      types.String `tfsdk:"interval"`
+	IntervalCount types.Int64  `tfsdk:"interval_count"`
+}
+
+func (m ProductDefaultPriceDataRecurringModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"interval":       types.StringType,
+		"interval_count": types.Int64Type,
+	}
+}
+
 func (r *ProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_product"
 }
@@ -95,10 +141,76 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"config_hash": configHashAttribute(),
 			"default_price": schema.StringAttribute{
-				MarkdownDescription: "The ID of the Price object that is the default price for this product.",
+				MarkdownDescription: "The ID of the Price object that is the default price for this product. Stripe does not allow unsetting the default price, so removing this attribute keeps the current default in place; archive the price to retire it. A price must reference its product, so referencing a `stripe_price` of this product here creates a cycle. Create the product without a default price first, then set this to the ID of the price in a later apply, for example through a variable.",
 				Required:            false,
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_price_data": schema.SingleNestedAttribute{
+				MarkdownDescription: "Data used to create a new Price object that becomes the default price of the product, whose ID is read into `default_price`. Only used when the product is created, so changing it replaces the product while removing it keeps the product and its default price. Manage further prices with `stripe_price`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"currency": schema.StringAttribute{
+						MarkdownDescription: "Three-letter ISO currency code, in lowercase.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(lowercaseCurrencyRegexp, "must be a lowercase three-letter ISO currency code"),
+						},
+					},
+					"recurring": schema.SingleNestedAttribute{
+						MarkdownDescription: "The recurring components of the price. A price without them is a one-time price.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"interval": schema.StringAttribute{
+								MarkdownDescription: "Specifies billing frequency. Either `day`, `week`, `month` or `year`.",
+								Required:            true,
+								Validators: []validator.String{
+									stringvalidator.OneOf("day", "week", "month", "year"),
+								},
+							},
+							"interval_count": schema.Int64Attribute{
+								MarkdownDescription: "The number of intervals between subscription billings.",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+						},
+					},
+					"tax_behavior": schema.StringAttribute{
+						MarkdownDescription: "Specifies whether the price is considered inclusive of taxes or exclusive of taxes. One of `inclusive`, `exclusive`, or `unspecified`.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("exclusive", "inclusive", "unspecified"),
+						},
+					},
+					"unit_amount": schema.Int64Attribute{
+						MarkdownDescription: "A positive integer in cents (or 0 for a free price) representing how much to charge.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("unit_amount_decimal")),
+						},
+					},
+					"unit_amount_decimal": schema.Float64Attribute{
+						MarkdownDescription: "Same as `unit_amount`, but accepts a decimal value in cents with at most 12 decimal places.",
+						Optional:            true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0),
+						},
+					},
+				},
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("default_price")),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The product’s description, meant to be displayable to the customer.",
@@ -106,12 +218,14 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"images": schema.ListAttribute{
-				MarkdownDescription: "A list of up to 8 URLs of images for this product, meant to be displayable to the customer.",
+				MarkdownDescription: "A list of up to 8 HTTPS URLs of images for this product, meant to be displayable to the customer.",
 				ElementType:         types.StringType,
 				Optional:            true,
 				Validators: []validator.List{
 					listvalidator.UniqueValues(),
 					listvalidator.SizeAtMost(8),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(httpsURLRegexp, "must be a valid HTTPS URL")),
 				},
 			},
 			"marketing_features": schema.ListAttribute{
@@ -124,18 +238,8 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 					listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(80)),
 				},
 			},
-			"metadata": schema.MapAttribute{
-				MarkdownDescription: "Set of key-value pairs that you can attach to an object. ",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Validators: []validator.Map{
-					mapvalidator.SizeAtMost(50),
-					mapvalidator.KeysAre(
-						stringvalidator.LengthAtMost(40)),
-					mapvalidator.ValueStringsAre(
-						stringvalidator.LengthAtMost(500)),
-				},
-			},
+			"metadata":      metadataAttribute(),
+			"metadata_keys": metadataKeysAttribute(),
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The product’s name, meant to be displayable to the customer.",
 				Required:            true,
@@ -162,6 +266,7 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"require_livemode": requireLivemodeAttribute(),
 			"shippable": schema.BoolAttribute{
 				MarkdownDescription: "Whether this product is shipped (i.e., physical goods).",
 				Optional:            true,
@@ -188,24 +293,143 @@ func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var packageDimensions types.Object
+	var shippable types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("package_dimensions"), &packageDimensions)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("shippable"), &shippable)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// shippable defaults to false, so an unset value counts as false.
+	if packageDimensions.IsNull() || packageDimensions.IsUnknown() || shippable.IsUnknown() || shippable.ValueBool() {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("package_dimensions"),
+		"Package dimensions on a product that is not shippable",
+		"package_dimensions is set, but shippable is false. Package dimensions only apply to shippable products; set shippable = true or remove package_dimensions.",
+	)
+}
+
 func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	sc, ok := req.ProviderData.(*client.API)
+	providerData, ok := req.ProviderData.(*StripeProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sc = sc
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *ProductResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The prior configuration is not known, so only warn when the plan
+	// changes something rather than on every plan of a product whose
+	// default price was imported or set outside Terraform.
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+		var configDefaultPrice, stateDefaultPrice types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_price"), &configDefaultPrice)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("default_price"), &stateDefaultPrice)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// A default price created from default_price_data is expected to stay.
+		var configDefaultPriceData types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_price_data"), &configDefaultPriceData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if configDefaultPrice.IsNull() && configDefaultPriceData.IsNull() && !stateDefaultPrice.IsNull() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("default_price"),
+				"Default price kept",
+				fmt.Sprintf("Stripe does not allow unsetting a product's default price, so %s remains the default price. Archive the price to stop it being used, or set default_price to another price.", stateDefaultPrice.ValueString()),
+			)
+		}
+	}
+
+	// Without a configured provider, such as when credentials are not known
+	// yet, nothing can be checked against the API.
+	if r.providerData == nil {
+		return
+	}
+
+	if r.providerData.ValidateAgainstAPI {
+		resp.Diagnostics.Append(r.validateTaxCode(ctx, req)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !r.providerData.WarnMissingTaxCode {
+		return
+	}
+
+	var taxCode types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tax_code"), &taxCode)...)
+	if resp.Diagnostics.HasError() || !taxCode.IsNull() {
+		return
+	}
+
+	active, err := r.providerData.TaxSettingsActive()
+	if err != nil {
+		resp.Diagnostics.AddWarning("Client Error", fmt.Sprintf("Unable to read tax settings, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	if active {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("tax_code"),
+			"Missing tax code",
+			"Stripe Tax is active on this account but the product has no tax_code set. The account's default tax code will be used when calculating tax.",
+		)
+	}
+}
+
+// validateTaxCode returns an error diagnostic when a new or changed tax_code
+// does not exist in Stripe. Other API errors only produce a warning, as they
+// do not tell whether the tax code is valid.
+func (r *ProductResource) validateTaxCode(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planTaxCode, stateTaxCode types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("tax_code"), &planTaxCode)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("tax_code"), &stateTaxCode)...)
+	}
+	if diags.HasError() || planTaxCode.IsNull() || planTaxCode.IsUnknown() || planTaxCode.Equal(stateTaxCode) {
+		return diags
+	}
+
+	_, err := r.sc.TaxCodes.Get(planTaxCode.ValueString(), nil)
+	if isNotFound(err) {
+		diags.AddAttributeError(
+			path.Root("tax_code"),
+			"Unknown tax code",
+			fmt.Sprintf("The tax code %s does not exist in Stripe.", planTaxCode.ValueString()),
+		)
+	} else if err != nil {
+		diags.AddWarning("Client Error", fmt.Sprintf("Unable to validate tax code, got error: %s", formatStripeError(err)))
+	}
+	return diags
 }
 
 func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -219,6 +443,11 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := r.buildCreateParams(ctx, plan, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -226,13 +455,18 @@ func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest
 
 	product, err = r.sc.Products.New(params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create product, got error: %s", formatStripeError(err)))
 		return
 	}
 
 	plan.Id = types.StringValue(product.ID)
-	r.populateModel(ctx, &plan, product, resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	var populateDiags diag.Diagnostics
+	r.populateModel(ctx, &plan, product, &populateDiags)
+	resp.Diagnostics.Append(populateDiags...)
+	if populateDiags.HasError() {
+		// Keep track of the created product so that it is not leaked. The
+		// error taints it, so it is replaced on the next apply.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
 		return
 	}
 
@@ -257,11 +491,16 @@ func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	product, err = r.sc.Products.Get(state.Id.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product, got error: %s", formatStripeError(err)))
 		return
 	}
 
-	r.populateModel(ctx, &state, product, resp.Diagnostics)
+	resp.Diagnostics.Append(checkStripeObject(product.Object, "product", product.ID, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.populateModel(ctx, &state, product, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -294,11 +533,11 @@ func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	product, err = r.sc.Products.Update(plan.Id.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update product, got error: %s", formatStripeError(err)))
 		return
 	}
 
-	r.populateModel(ctx, &plan, product, resp.Diagnostics)
+	r.populateModel(ctx, &plan, product, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -319,7 +558,7 @@ func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	_, err = r.sc.Products.Del(state.Id.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete product, got error: %s", formatStripeError(err)))
 		return
 	}
 }
@@ -329,14 +568,24 @@ func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportSt
 	var product *stripe.Product
 	var err error
 
+	resp.Diagnostics.Append(checkImportID(req.ID, "product", "prod_", true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	product, err = r.sc.Products.Get(req.ID, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import product, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(product.Object, "product", product.ID, "")...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state.Id = types.StringValue(req.ID)
-	r.populateModel(ctx, &state, product, resp.Diagnostics)
+	r.populateModel(ctx, &state, product, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -345,11 +594,61 @@ func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *ProductResource) populateModel(ctx context.Context, model *ProductResourceModel, product *stripe.Product, respDiag diag.Diagnostics) {
+func (r *ProductResource) MoveState(ctx context.Context) []resource.StateMover {
+	return moveStateMovers("stripe_product", func(ctx context.Context, attrs map[string]any, resp *resource.MoveStateResponse) {
+		state := ProductResourceModel{
+			Id:                  movedString(attrs, "id"),
+			Active:              movedBool(attrs, "active"),
+			DefaultPrice:        movedString(attrs, "default_price"),
+			DefaultPriceData:    types.ObjectNull(ProductDefaultPriceDataModel{}.Types()),
+			Description:         movedString(attrs, "description"),
+			MarketingFeatures:   types.ListNull(types.StringType),
+			Name:                movedString(attrs, "name"),
+			PackageDimensions:   types.ObjectNull(ProductPackageDimensionsResourceModel{}.Types()),
+			Shippable:           movedBool(attrs, "shippable"),
+			StatementDescriptor: movedString(attrs, "statement_descriptor"),
+			TaxCode:             movedString(attrs, "tax_code"),
+			UnitLabel:           movedString(attrs, "unit_label"),
+			URL:                 movedString(attrs, "url"),
+		}
+
+		images, diags := types.ListValueFrom(ctx, types.StringType, movedStrings(attrs, "images"))
+		resp.Diagnostics.Append(diags...)
+		state.Images = ListValueNullIfEmpty(images, types.StringType)
+
+		metadata, diags := types.MapValueFrom(ctx, types.StringType, movedStringMap(attrs, "metadata"))
+		resp.Diagnostics.Append(diags...)
+		state.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+		state.MetadataKeys = metadataKeys(state.Metadata)
+
+		if pd := movedObject(attrs, "package_dimensions"); pd != nil {
+			p, diags := types.ObjectValueFrom(ctx, ProductPackageDimensionsResourceModel{}.Types(), ProductPackageDimensionsResourceModel{
+				Height: movedFloat64(pd, "height"),
+				Length: movedFloat64(pd, "length"),
+				Weight: movedFloat64(pd, "weight"),
+				Width:  movedFloat64(pd, "width"),
+			})
+			resp.Diagnostics.Append(diags...)
+			state.PackageDimensions = p
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.TargetState.Set(ctx, &state)...)
+	})
+}
+
+func (r *ProductResource) populateModel(ctx context.Context, model *ProductResourceModel, product *stripe.Product, respDiag *diag.Diagnostics) {
 	model.Active = types.BoolValue(product.Active)
 	if product.DefaultPrice != nil {
 		model.DefaultPrice = types.StringValue(product.DefaultPrice.ID)
-
+	} else {
+		model.DefaultPrice = types.StringNull()
+	}
+	// default_price_data is only sent on create and not returned by Stripe.
+	if model.DefaultPriceData.IsNull() {
+		model.DefaultPriceData = types.ObjectNull(ProductDefaultPriceDataModel{}.Types())
 	}
 	model.Description = StringNullIfEmpty(product.Description)
 	images, diags := types.ListValueFrom(ctx, types.StringType, product.Images)
@@ -357,22 +656,26 @@ func (r *ProductResource) populateModel(ctx context.Context, model *ProductResou
 		respDiag.Append(diags...)
 	}
 	model.Images = ListValueNullIfEmpty(images, types.StringType)
-	if product.MarketingFeatures != nil {
-		var marketingFeatures []string
-		for _, v := range product.MarketingFeatures {
+	// Features are kept in the order Stripe returns them, and are not capped
+	// at the 15 allowed in configuration so that any extra features show up
+	// as a difference rather than being dropped.
+	var marketingFeatures []string
+	for _, v := range product.MarketingFeatures {
+		if v != nil {
 			marketingFeatures = append(marketingFeatures, v.Name)
 		}
-		m, diags := types.ListValueFrom(ctx, types.StringType, marketingFeatures)
-		if diags.HasError() {
-			respDiag.Append(diags...)
-		}
-		model.MarketingFeatures = ListValueNullIfEmpty(m, types.StringType)
 	}
+	m, diags := types.ListValueFrom(ctx, types.StringType, marketingFeatures)
+	if diags.HasError() {
+		respDiag.Append(diags...)
+	}
+	model.MarketingFeatures = ListValueNullIfEmpty(m, types.StringType)
 	metadata, diags := types.MapValueFrom(ctx, types.StringType, product.Metadata)
 	if diags.HasError() {
 		respDiag.Append(diags...)
 	}
 	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.MetadataKeys = metadataKeys(model.Metadata)
 	model.Name = types.StringValue(product.Name)
 	if product.PackageDimensions != nil && product.PackageDimensions.Height != 0 && product.PackageDimensions.Length != 0 && product.PackageDimensions.Weight != 0 && product.PackageDimensions.Width != 0 {
 		p, diags := types.ObjectValueFrom(
@@ -400,6 +703,21 @@ func (r *ProductResource) populateModel(ctx context.Context, model *ProductResou
 	}
 	model.UnitLabel = StringNullIfEmpty(product.UnitLabel)
 	model.URL = StringNullIfEmpty(product.URL)
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"active":               model.Active,
+		"default_price":        model.DefaultPrice,
+		"description":          model.Description,
+		"images":               model.Images,
+		"marketing_features":   model.MarketingFeatures,
+		"metadata":             model.Metadata,
+		"name":                 model.Name,
+		"package_dimensions":   model.PackageDimensions,
+		"shippable":            model.Shippable,
+		"statement_descriptor": model.StatementDescriptor,
+		"tax_code":             model.TaxCode,
+		"unit_label":           model.UnitLabel,
+		"url":                  model.URL,
+	})
 }
 
 func (r *ProductResource) buildCreateParams(ctx context.Context, plan ProductResourceModel, respDiag diag.Diagnostics) *stripe.ProductParams {
@@ -413,6 +731,30 @@ func (r *ProductResource) buildCreateParams(ctx context.Context, plan ProductRes
 	if !plan.DefaultPrice.IsUnknown() {
 		params.DefaultPrice = plan.DefaultPrice.ValueStringPointer()
 	}
+	if !plan.DefaultPriceData.IsUnknown() && !plan.DefaultPriceData.IsNull() {
+		var defaultPriceData ProductDefaultPriceDataModel
+		diags := plan.DefaultPriceData.As(ctx, &defaultPriceData, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			respDiag.Append(diags...)
+		}
+		params.DefaultPriceData = &stripe.ProductDefaultPriceDataParams{
+			Currency:          defaultPriceData.Currency.ValueStringPointer(),
+			TaxBehavior:       defaultPriceData.TaxBehavior.ValueStringPointer(),
+			UnitAmount:        defaultPriceData.UnitAmount.ValueInt64Pointer(),
+			UnitAmountDecimal: defaultPriceData.UnitAmountDecimal.ValueFloat64Pointer(),
+		}
+		if !defaultPriceData.Recurring.IsUnknown() && !defaultPriceData.Recurring.IsNull() {
+			var recurring ProductDefaultPriceDataRecurringModel
+			diags := defaultPriceData.Recurring.As(ctx, &recurring, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				respDiag.Append(diags...)
+			}
+			params.DefaultPriceData.Recurring = &stripe.ProductDefaultPriceDataRecurringParams{
+				Interval:      recurring.Interval.ValueStringPointer(),
+				IntervalCount: recurring.IntervalCount.ValueInt64Pointer(),
+			}
+		}
+	}
 	if !plan.Description.IsUnknown() {
 		params.Description = plan.Description.ValueStringPointer()
 	}
@@ -479,8 +821,9 @@ func (r *ProductResource) buildUpdateParams(ctx context.Context, state, plan Pro
 	if !plan.Active.Equal(state.Active) {
 		params.Active = plan.Active.ValueBoolPointer()
 	}
-	if !plan.DefaultPrice.Equal(state.DefaultPrice) {
-		params.DefaultPrice = EmptyStringIfNull(plan.DefaultPrice)
+	// Stripe rejects an empty default_price, so a removed default price is left in place.
+	if !plan.DefaultPrice.Equal(state.DefaultPrice) && !plan.DefaultPrice.IsNull() && !plan.DefaultPrice.IsUnknown() {
+		params.DefaultPrice = plan.DefaultPrice.ValueStringPointer()
 	}
 	if !plan.Description.Equal(state.Description) {
 		params.Description = EmptyStringIfNull(plan.Description)
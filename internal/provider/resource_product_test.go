@@ -2,15 +2,29 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stripe/stripe-go/v81"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stripe/stripe-go/v81/client"
 )
 
 const (
@@ -63,6 +77,173 @@ func TestAccProductResource(t *testing.T) {
 	})
 }
 
+func TestAccProductResourceReactivate(t *testing.T) {
+	var productID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create an active product
+			{
+				Config: testAccProductResourceConfigCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_product.test", "active", "true"),
+					func(s *terraform.State) error {
+						productID = s.RootModule().Resources["stripe_product.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Archive the product outside of Terraform and refresh
+			{
+				PreConfig: func() {
+					sc := client.New(os.Getenv("STRIPE_API_KEY"), nil)
+					if _, err := sc.Products.Update(productID, &stripe.ProductParams{Active: stripe.Bool(false)}); err != nil {
+						t.Fatalf("failed to archive product: %s", err)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_product.test", "active", "false"),
+				),
+			},
+			// Reactivate the product
+			{
+				Config: testAccProductResourceConfigCreate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("stripe_product.test", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+// testAccProductResourceConfigDefaultPrice creates a product and a price of
+// it. The product cannot reference the price directly, since the price
+// references the product, so its default price is passed in as a variable.
+const testAccProductResourceConfigDefaultPrice string = `
+variable "default_price" {
+  type    = string
+  default = null
+}
+
+resource "stripe_product" "test" {
+  name          = "test_default_price"
+  default_price = var.default_price
+}
+
+resource "stripe_price" "test" {
+  product     = stripe_product.test.id
+  currency    = "usd"
+  unit_amount = 1000
+}
+`
+
+// testAccLazyVariable is a configuration variable whose value is read when
+// the step runs, so that it can take an ID recorded by an earlier step.
+type testAccLazyVariable struct {
+	value *string
+}
+
+func (v testAccLazyVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*v.value)
+}
+
+func TestAccProductResourceDefaultPrice(t *testing.T) {
+	var priceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the product without a default price, then the price
+			{
+				Config: testAccProductResourceConfigDefaultPrice,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("stripe_product.test", "default_price"),
+					resource.TestCheckResourceAttrPair("stripe_price.test", "product", "stripe_product.test", "id"),
+					func(s *terraform.State) error {
+						priceID = s.RootModule().Resources["stripe_price.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Set the price as default price of the product
+			{
+				Config: testAccProductResourceConfigDefaultPrice,
+				ConfigVariables: config.Variables{
+					"default_price": testAccLazyVariable{value: &priceID},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stripe_product.test", "default_price", "stripe_price.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccProductResourceConfigDefaultPriceData string = `
+resource "stripe_product" "test" {
+  name = "test_default_price_data"
+  default_price_data = {
+    currency    = "usd"
+    unit_amount = 1000
+    recurring = {
+      interval = "month"
+    }
+  }
+}
+`
+
+const testAccProductResourceConfigDefaultPriceDataChanged string = `
+resource "stripe_product" "test" {
+  name = "test_default_price_data"
+  default_price_data = {
+    currency    = "usd"
+    unit_amount = 2000
+    recurring = {
+      interval = "month"
+    }
+  }
+}
+`
+
+func TestAccProductResourceDefaultPriceData(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the product with an inline default price
+			{
+				Config: testAccProductResourceConfigDefaultPriceData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("stripe_product.test", "default_price"),
+				),
+			},
+			// Apply again without changes
+			{
+				Config: testAccProductResourceConfigDefaultPriceData,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Change the inline default price, which only applies on create
+			{
+				Config: testAccProductResourceConfigDefaultPriceDataChanged,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stripe_product.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestPopulateModelProductResource(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -99,10 +280,12 @@ func TestPopulateModelProductResource(t *testing.T) {
 			expected: ProductResourceModel{
 				Active:              types.BoolValue(true),
 				DefaultPrice:        types.StringValue("price_123"),
+				DefaultPriceData:    types.ObjectNull(ProductDefaultPriceDataModel{}.Types()),
 				Description:         types.StringValue("A product"),
 				Images:              testListValue(t, types.StringType, []string{"image1", "image2"}),
 				MarketingFeatures:   testListValue(t, types.StringType, []string{"Feature 1"}),
 				Metadata:            testMapValue(t, types.StringType, map[string]interface{}{"foo": "bar"}),
+				MetadataKeys:        testListValue(t, types.StringType, []string{"foo"}),
 				Name:                types.StringValue("Product 1"),
 				PackageDimensions:   buildPackageDimensionsModel(t, 1.5, 2.0, 0.5, 1.0),
 				Shippable:           types.BoolValue(true),
@@ -138,10 +321,12 @@ func TestPopulateModelProductResource(t *testing.T) {
 			expected: ProductResourceModel{
 				Active:              types.BoolValue(false),
 				DefaultPrice:        types.StringNull(),
+				DefaultPriceData:    types.ObjectNull(ProductDefaultPriceDataModel{}.Types()),
 				Description:         types.StringNull(),
 				Images:              types.ListNull(types.StringType),
 				MarketingFeatures:   types.ListNull(types.StringType),
 				Metadata:            testMapValue(t, types.StringType, nil),
+				MetadataKeys:        testListValue(t, types.StringType, []string{}),
 				Name:                types.StringValue(""),
 				PackageDimensions:   types.ObjectNull(ProductPackageDimensionsResourceModel{}.Types()),
 				Shippable:           types.BoolValue(false),
@@ -160,8 +345,11 @@ func TestPopulateModelProductResource(t *testing.T) {
 			var diags diag.Diagnostics
 
 			r := &ProductResource{}
-			r.populateModel(context.Background(), &model, tt.product, diags)
+			r.populateModel(context.Background(), &model, tt.product, &diags)
 
+			// The hash is covered by TestPopulateModelProductResourceConfigHash.
+			assert.False(t, model.ConfigHash.IsNull())
+			tt.expected.ConfigHash = model.ConfigHash
 			assert.Equal(t, tt.expected, model)
 			if tt.expectDiag {
 				assert.True(t, diags.HasError())
@@ -222,6 +410,34 @@ func TestBuildCreateParamsProductResource(t *testing.T) {
 				Name: stripe.String("Product 2"),
 			},
 		},
+		{
+			name: "Default price data",
+			plan: ProductResourceModel{
+				DefaultPrice: types.StringUnknown(),
+				DefaultPriceData: types.ObjectValueMust(ProductDefaultPriceDataModel{}.Types(), map[string]attr.Value{
+					"currency": types.StringValue("usd"),
+					"recurring": types.ObjectValueMust(ProductDefaultPriceDataRecurringModel{}.Types(), map[string]attr.Value{
+						"interval":       types.StringValue("month"),
+						"interval_count": types.Int64Null(),
+					}),
+					"tax_behavior":        types.StringValue("exclusive"),
+					"unit_amount":         types.Int64Value(1000),
+					"unit_amount_decimal": types.Float64Null(),
+				}),
+				Name: types.StringValue("Product 3"),
+			},
+			expected: &stripe.ProductParams{
+				DefaultPriceData: &stripe.ProductDefaultPriceDataParams{
+					Currency: stripe.String("usd"),
+					Recurring: &stripe.ProductDefaultPriceDataRecurringParams{
+						Interval: stripe.String("month"),
+					},
+					TaxBehavior: stripe.String("exclusive"),
+					UnitAmount:  stripe.Int64(1000),
+				},
+				Name: stripe.String("Product 3"),
+			},
+		},
 		{
 			name: "Empty fields",
 			plan: ProductResourceModel{
@@ -368,6 +584,43 @@ func TestBuildUpdateParamsProductResource(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Default price removed",
+			state: ProductResourceModel{
+				DefaultPrice: types.StringValue("price_123"),
+			},
+			plan: ProductResourceModel{
+				DefaultPrice: types.StringNull(),
+			},
+			expected: &stripe.ProductParams{
+				MarketingFeatures: []*stripe.ProductMarketingFeatureParams{},
+			},
+		},
+		{
+			name: "Default price set after creation",
+			state: ProductResourceModel{
+				DefaultPrice: types.StringNull(),
+			},
+			plan: ProductResourceModel{
+				DefaultPrice: types.StringValue("price_123"),
+			},
+			expected: &stripe.ProductParams{
+				DefaultPrice:      stripe.String("price_123"),
+				MarketingFeatures: []*stripe.ProductMarketingFeatureParams{},
+			},
+		},
+		{
+			name: "Default price unknown",
+			state: ProductResourceModel{
+				DefaultPrice: types.StringNull(),
+			},
+			plan: ProductResourceModel{
+				DefaultPrice: types.StringUnknown(),
+			},
+			expected: &stripe.ProductParams{
+				MarketingFeatures: []*stripe.ProductMarketingFeatureParams{},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -380,6 +633,169 @@ func TestBuildUpdateParamsProductResource(t *testing.T) {
 	}
 }
 
+func TestModifyPlanProductResourceMissingTaxCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		warn          bool
+		taxCode       types.String
+		status        string
+		expectWarning bool
+		expectCalls   int
+	}{
+		{"disabled", false, types.StringNull(), "active", false, 0},
+		{"tax code set", true, types.StringValue("txcd_10000000"), "active", false, 0},
+		{"stripe tax active", true, types.StringNull(), "active", true, 1},
+		{"stripe tax pending", true, types.StringNull(), "pending", false, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			sc := testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				fmt.Fprintf(w, `{"object": "tax.settings", "status": %q}`, tt.status)
+			})
+			r := &ProductResource{
+				sc:           sc,
+				providerData: &StripeProviderData{Client: sc, WarnMissingTaxCode: tt.warn},
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"name":     types.StringValue("Product"),
+				"tax_code": tt.taxCode,
+			})
+
+			// Run twice to ensure the tax settings are only fetched once.
+			for i := 0; i < 2; i++ {
+				resp := &fwresource.ModifyPlanResponse{Plan: plan}
+				r.ModifyPlan(context.Background(), fwresource.ModifyPlanRequest{Plan: plan}, resp)
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() == 1)
+			}
+			assert.Equal(t, tt.expectCalls, calls)
+		})
+	}
+}
+
+func TestModifyPlanProductResourceValidateAgainstAPI(t *testing.T) {
+	tests := []struct {
+		name          string
+		validate      bool
+		stateTaxCode  types.String
+		planTaxCode   types.String
+		status        int
+		expectError   bool
+		expectWarning bool
+		expectCalls   int
+	}{
+		{"disabled", false, types.StringNull(), types.StringValue("txcd_99999999"), http.StatusNotFound, false, false, 0},
+		{"no tax code", true, types.StringNull(), types.StringNull(), http.StatusOK, false, false, 0},
+		{"tax code exists", true, types.StringNull(), types.StringValue("txcd_10000000"), http.StatusOK, false, false, 1},
+		{"tax code missing", true, types.StringNull(), types.StringValue("txcd_99999999"), http.StatusNotFound, true, false, 1},
+		{"tax code unchanged", true, types.StringValue("txcd_99999999"), types.StringValue("txcd_99999999"), http.StatusNotFound, false, false, 0},
+		{"api error", true, types.StringNull(), types.StringValue("txcd_10000000"), http.StatusUnauthorized, false, true, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			sc := testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				w.WriteHeader(tt.status)
+				switch tt.status {
+				case http.StatusOK:
+					_, _ = w.Write([]byte(`{"id": "txcd_10000000", "object": "tax_code"}`))
+				case http.StatusNotFound:
+					_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "code": "resource_missing", "message": "No such tax code"}}`))
+				default:
+					_, _ = w.Write([]byte(`{"error": {"type": "invalid_request_error", "message": "Invalid API Key provided"}}`))
+				}
+			})
+			r := &ProductResource{
+				sc:           sc,
+				providerData: &StripeProviderData{Client: sc, ValidateAgainstAPI: tt.validate},
+			}
+			state := testState(t, r, nil)
+			if !tt.stateTaxCode.IsNull() {
+				state = testState(t, r, map[string]interface{}{
+					"id":       types.StringValue("prod_123"),
+					"name":     types.StringValue("Product"),
+					"tax_code": tt.stateTaxCode,
+				})
+			}
+			config := testPlan(t, r, map[string]interface{}{
+				"name":     types.StringValue("Product"),
+				"tax_code": tt.planTaxCode,
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				State:  state,
+				Plan:   config,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() == 1, resp.Diagnostics)
+			assert.Equal(t, tt.expectCalls, calls)
+		})
+	}
+}
+
+func TestModifyPlanProductResourceDefaultPriceRemoved(t *testing.T) {
+	defaultPriceData := types.ObjectValueMust(ProductDefaultPriceDataModel{}.Types(), map[string]attr.Value{
+		"currency":            types.StringValue("usd"),
+		"recurring":           types.ObjectNull(ProductDefaultPriceDataRecurringModel{}.Types()),
+		"tax_behavior":        types.StringNull(),
+		"unit_amount":         types.Int64Value(1000),
+		"unit_amount_decimal": types.Float64Null(),
+	})
+
+	tests := []struct {
+		name                   string
+		configDefaultPrice     types.String
+		configDefaultPriceData types.Object
+		planName               string
+		expectWarning          bool
+	}{
+		{"default price removed", types.StringNull(), types.ObjectNull(ProductDefaultPriceDataModel{}.Types()), "Product renamed", true},
+		{"default price kept", types.StringValue("price_123"), types.ObjectNull(ProductDefaultPriceDataModel{}.Types()), "Product renamed", false},
+		{"default price from default_price_data", types.StringNull(), defaultPriceData, "Product renamed", false},
+		{"no changes", types.StringNull(), types.ObjectNull(ProductDefaultPriceDataModel{}.Types()), "Product", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ProductResource{}
+			state := testState(t, r, map[string]interface{}{
+				"id":            types.StringValue("prod_123"),
+				"default_price": types.StringValue("price_123"),
+				"name":          types.StringValue("Product"),
+			})
+			config := testPlan(t, r, map[string]interface{}{
+				"id":                 types.StringValue("prod_123"),
+				"default_price":      tt.configDefaultPrice,
+				"default_price_data": tt.configDefaultPriceData,
+				"name":               types.StringValue(tt.planName),
+			})
+			plan := testPlan(t, r, map[string]interface{}{
+				"id":            types.StringValue("prod_123"),
+				"default_price": types.StringValue("price_123"),
+				"name":          types.StringValue(tt.planName),
+			})
+			req := fwresource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				State:  state,
+				Plan:   plan,
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() == 1)
+		})
+	}
+}
+
 func buildPackageDimensionsModel(t *testing.T, height, length, weight, width float64) types.Object {
 	p, diags := types.ObjectValueFrom(
 		context.Background(),
@@ -401,3 +817,274 @@ func buildPackageDimensionsModel(t *testing.T, height, length, weight, width flo
 	}
 	return p
 }
+
+func TestCreateProductResourceRequireLivemode(t *testing.T) {
+	tests := []struct {
+		name            string
+		requireLivemode types.Bool
+		expectError     bool
+		expectCreate    bool
+	}{
+		{"not required", types.BoolNull(), false, true},
+		{"mode matches", types.BoolValue(false), false, true},
+		{"mode mismatch", types.BoolValue(true), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			sc := testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/v1/balance":
+					_, _ = w.Write([]byte(`{"object":"balance","livemode":false}`))
+				case "/v1/products":
+					created = true
+					_, _ = w.Write([]byte(`{"id":"prod_123","object":"product","active":true,"name":"Product"}`))
+				}
+			})
+			r := &ProductResource{
+				sc:           sc,
+				providerData: &StripeProviderData{Client: sc},
+			}
+			plan := testPlan(t, r, map[string]interface{}{
+				"active":           types.BoolValue(true),
+				"name":             types.StringValue("Product"),
+				"require_livemode": tt.requireLivemode,
+				"shippable":        types.BoolValue(false),
+			})
+			resp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+			r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+
+			assert.Equal(t, tt.expectError, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.expectCreate, created)
+		})
+	}
+}
+
+func TestPopulateModelProductResourceMarketingFeatures(t *testing.T) {
+	var overLimit []*stripe.ProductMarketingFeature
+	var overLimitNames []string
+	for i := 17; i > 0; i-- {
+		name := fmt.Sprintf("Feature %d", i)
+		overLimit = append(overLimit, &stripe.ProductMarketingFeature{Name: name})
+		overLimitNames = append(overLimitNames, name)
+	}
+
+	tests := []struct {
+		name     string
+		prior    types.List
+		features []*stripe.ProductMarketingFeature
+		want     types.List
+	}{
+		{
+			name:     "removed outside of terraform",
+			prior:    testListValue(t, types.StringType, []string{"Feature 1"}),
+			features: nil,
+			want:     types.ListNull(types.StringType),
+		},
+		{
+			name:     "over the configuration limit",
+			prior:    types.ListNull(types.StringType),
+			features: overLimit,
+			want:     testListValue(t, types.StringType, overLimitNames),
+		},
+		{
+			name:     "missing feature",
+			prior:    types.ListNull(types.StringType),
+			features: []*stripe.ProductMarketingFeature{{Name: "Feature 1"}, nil, {Name: "Feature 2"}},
+			want:     testListValue(t, types.StringType, []string{"Feature 1", "Feature 2"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := ProductResourceModel{MarketingFeatures: tt.prior}
+			var diags diag.Diagnostics
+
+			r := &ProductResource{}
+			r.populateModel(context.Background(), &model, &stripe.Product{
+				ID:                "prod_123",
+				MarketingFeatures: tt.features,
+				Name:              "Product",
+			}, &diags)
+
+			assert.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, model.MarketingFeatures)
+		})
+	}
+}
+
+func TestPopulateModelProductResourceConfigHash(t *testing.T) {
+	product := func() *stripe.Product {
+		return &stripe.Product{
+			ID:       "prod_123",
+			Active:   true,
+			Metadata: map[string]string{"foo": "bar", "baz": "qux"},
+			Name:     "Product",
+			Updated:  1700000000,
+		}
+	}
+	hash := func(p *stripe.Product) types.String {
+		var model ProductResourceModel
+		r := &ProductResource{}
+		r.populateModel(context.Background(), &model, p, &diag.Diagnostics{})
+		return model.ConfigHash
+	}
+
+	base := hash(product())
+	assert.Len(t, base.ValueString(), 64)
+
+	// Reading the same object again, or one that only differs in
+	// unmanaged fields, keeps the hash.
+	assert.Equal(t, base, hash(product()))
+	updated := product()
+	updated.Updated = 1800000000
+	assert.Equal(t, base, hash(updated))
+
+	renamed := product()
+	renamed.Name = "Renamed"
+	assert.NotEqual(t, base, hash(renamed))
+
+	metadataChanged := product()
+	metadataChanged.Metadata["foo"] = "changed"
+	assert.NotEqual(t, base, hash(metadataChanged))
+}
+
+func TestReadProductResourceArchived(t *testing.T) {
+	r := &ProductResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"prod_123","object":"product","active":false,"name":"Product"}`))
+		}),
+	}
+	state := testState(t, r, map[string]interface{}{
+		"id":     types.StringValue("prod_123"),
+		"active": types.BoolValue(true),
+		"name":   types.StringValue("Product"),
+	})
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(context.Background(), fwresource.ReadRequest{State: state}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var active types.Bool
+	resp.State.GetAttribute(context.Background(), path.Root("active"), &active)
+	assert.Equal(t, types.BoolValue(false), active)
+}
+
+func TestSchemaProductResourceImagesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		images  []string
+		wantErr bool
+	}{
+		{"https", []string{"https://example.com/image.png"}, false},
+		{"http", []string{"https://example.com/a.png", "http://example.com/b.png"}, true},
+		{"not a url", []string{"image.png"}, true},
+		{"empty host", []string{"https://"}, true},
+	}
+
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&ProductResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	images := schemaResp.Schema.Attributes["images"].(schema.ListAttribute)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ListRequest{
+				Path:        path.Root("images"),
+				ConfigValue: testListValue(t, types.StringType, tt.images),
+			}
+			resp := &validator.ListResponse{}
+			for _, v := range images.ListValidators() {
+				v.ValidateList(ctx, req, resp)
+			}
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestSchemaProductResourceDefaultPriceDataReplace(t *testing.T) {
+	attrTypes := ProductDefaultPriceDataModel{}.Types()
+	defaultPriceData := func(unitAmount int64) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"currency":            types.StringValue("usd"),
+			"recurring":           types.ObjectNull(ProductDefaultPriceDataRecurringModel{}.Types()),
+			"tax_behavior":        types.StringNull(),
+			"unit_amount":         types.Int64Value(unitAmount),
+			"unit_amount_decimal": types.Float64Null(),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		state       types.Object
+		plan        types.Object
+		wantReplace bool
+	}{
+		{"unchanged", defaultPriceData(1000), defaultPriceData(1000), false},
+		{"changed", defaultPriceData(1000), defaultPriceData(2000), true},
+		{"added", types.ObjectNull(attrTypes), defaultPriceData(1000), true},
+		{"removed", defaultPriceData(1000), types.ObjectNull(attrTypes), false},
+	}
+
+	ctx := context.Background()
+	schemaResp := &fwresource.SchemaResponse{}
+	(&ProductResource{}).Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	defaultPriceDataAttribute := schemaResp.Schema.Attributes["default_price_data"].(schema.SingleNestedAttribute)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ProductResource{}
+			state := testState(t, r, map[string]interface{}{
+				"id":                 types.StringValue("prod_123"),
+				"default_price_data": tt.state,
+			})
+			plan := testPlan(t, r, map[string]interface{}{
+				"id":                 types.StringValue("prod_123"),
+				"default_price_data": tt.plan,
+			})
+			req := planmodifier.ObjectRequest{
+				Path:        path.Root("default_price_data"),
+				Config:      tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+				ConfigValue: tt.plan,
+				Plan:        plan,
+				PlanValue:   tt.plan,
+				State:       state,
+				StateValue:  tt.state,
+			}
+			resp := &planmodifier.ObjectResponse{PlanValue: tt.plan}
+			for _, m := range defaultPriceDataAttribute.ObjectPlanModifiers() {
+				m.PlanModifyObject(ctx, req, resp)
+			}
+			assert.Equal(t, tt.wantReplace, resp.RequiresReplace)
+		})
+	}
+}
+
+func TestValidateConfigProductResource(t *testing.T) {
+	packageDimensions := buildPackageDimensionsModel(t, 1, 2, 3, 4)
+
+	tests := []struct {
+		name          string
+		attributes    map[string]interface{}
+		expectWarning bool
+	}{
+		{"no package dimensions", map[string]interface{}{"shippable": types.BoolValue(false)}, false},
+		{"shippable", map[string]interface{}{"package_dimensions": packageDimensions, "shippable": types.BoolValue(true)}, false},
+		{"not shippable", map[string]interface{}{"package_dimensions": packageDimensions, "shippable": types.BoolValue(false)}, true},
+		{"shippable unset", map[string]interface{}{"package_dimensions": packageDimensions}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ProductResource{}
+			tt.attributes["name"] = types.StringValue("Product")
+			plan := testPlan(t, r, tt.attributes)
+			config := tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{Config: config}, resp)
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.expectWarning, resp.Diagnostics.WarningsCount() == 1)
+		})
+	}
+}
@@ -3,14 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
-	"regexp"
+	"net"
+	"net/url"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -25,6 +30,15 @@ import (
 var _ resource.Resource = &WebhookEndpointResource{}
 var _ resource.ResourceWithConfigure = &WebhookEndpointResource{}
 var _ resource.ResourceWithImportState = &WebhookEndpointResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookEndpointResource{}
+
+// webhookEndpointReplaceAttributes are the attributes with a RequiresReplace
+// plan modifier, whose change forces a new webhook endpoint, and with it a new
+// secret, to be created. Keep it in sync with the schema.
+var webhookEndpointReplaceAttributes = []path.Path{
+	path.Root("api_version"),
+	path.Root("connect"),
+}
 
 func NewWebhookEndpointResource() resource.Resource {
 	return &WebhookEndpointResource{}
@@ -32,20 +46,27 @@ func NewWebhookEndpointResource() resource.Resource {
 
 // WebhookEndpointResource defines the resource implementation.
 type WebhookEndpointResource struct {
-	sc *client.API
+	sc           *client.API
+	providerData *StripeProviderData
 }
 
 // WebhookEndpointResourceModel describes the resource data model.
 type WebhookEndpointResourceModel struct {
-	Id            types.String `tfsdk:"id"`
-	APIVersion    types.String `tfsdk:"api_version"`
-	Application   types.String `tfsdk:"application"`
-	Description   types.String `tfsdk:"description"`
-	Disabled      types.Bool   `tfsdk:"disabled"`
-	EnabledEvents types.Set    `tfsdk:"enabled_events"`
-	Metadata      types.Map    `tfsdk:"metadata"`
-	Secret        types.String `tfsdk:"secret"`
-	URL           types.String `tfsdk:"url"`
+	Id                    types.String `tfsdk:"id"`
+	APIVersion            types.String `tfsdk:"api_version"`
+	Application           types.String `tfsdk:"application"`
+	ConfigHash            types.String `tfsdk:"config_hash"`
+	Connect               types.Bool   `tfsdk:"connect"`
+	Created               types.Int64  `tfsdk:"created"`
+	Description           types.String `tfsdk:"description"`
+	Disabled              types.Bool   `tfsdk:"disabled"`
+	EnabledEvents         types.Set    `tfsdk:"enabled_events"`
+	Metadata              types.Map    `tfsdk:"metadata"`
+	MetadataKeys          types.List   `tfsdk:"metadata_keys"`
+	PreventSecretRotation types.Bool   `tfsdk:"prevent_secret_rotation"`
+	RequireLivemode       types.Bool   `tfsdk:"require_livemode"`
+	Secret                types.String `tfsdk:"secret"`
+	URL                   types.String `tfsdk:"url"`
 }
 
 func (r *WebhookEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,7 +87,7 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"api_version": schema.StringAttribute{
-				MarkdownDescription: "The API version events are rendered as for this webhook endpoint.",
+				MarkdownDescription: "The API version events are rendered as for this webhook endpoint. Planning warns when it differs from the API version the provider uses.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -81,6 +102,21 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"config_hash": configHashAttribute(),
+			"connect": schema.BoolAttribute{
+				MarkdownDescription: "Whether this endpoint should receive events from connected accounts (`true`), or from your account (`false`). Stripe does not return this value, so it is kept as configured and imported endpoints have it unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Time at which the object was created. Measured in seconds since the Unix epoch.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "An optional description of what the webhook is used for.",
 				Optional:            true,
@@ -99,18 +135,13 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 				ElementType:         types.StringType,
 				Required:            true,
 			},
-			"metadata": schema.MapAttribute{
-				MarkdownDescription: "Set of key-value pairs that you can attach to an object.",
-				ElementType:         types.StringType,
+			"metadata":      metadataAttribute(),
+			"metadata_keys": metadataKeysAttribute(),
+			"prevent_secret_rotation": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, any change that would replace the webhook endpoint, and therefore rotate its secret, results in an error during planning.",
 				Optional:            true,
-				Validators: []validator.Map{
-					mapvalidator.SizeAtMost(50),
-					mapvalidator.KeysAre(
-						stringvalidator.LengthAtMost(40)),
-					mapvalidator.ValueStringsAre(
-						stringvalidator.LengthAtMost(500)),
-				},
 			},
+			"require_livemode": requireLivemodeAttribute(),
 			"secret": schema.StringAttribute{
 				MarkdownDescription: "The endpoint’s secret, used to generate webhook signatures.",
 				Computed:            true,
@@ -122,11 +153,11 @@ func (r *WebhookEndpointResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "The URL of the webhook endpoint.",
+				MarkdownDescription: "The URL of the webhook endpoint. With a live mode API key, localhost, private network and development tunnel (such as ngrok) URLs are rejected.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
-						regexp.MustCompile(`^https://`),
+						httpsURLRegexp,
 						"must be a valid HTTPS URL"),
 				},
 			},
@@ -140,18 +171,148 @@ func (r *WebhookEndpointResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	sc, ok := req.ProviderData.(*client.API)
+	providerData, ok := req.ProviderData.(*StripeProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *StripeProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.sc = sc
+	r.sc = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *WebhookEndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Live mode endpoints must not point at development URLs.
+	if r.providerData != nil && r.providerData.LiveAPIKey {
+		var endpointURL types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("url"), &endpointURL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if reason := developmentWebhookURL(endpointURL.ValueString()); reason != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("url"),
+				"Development URL in live mode",
+				fmt.Sprintf("The provider is configured with a live mode API key, but %s is %s. "+
+					"Use a production URL for live mode endpoints, or a test mode API key during development.", endpointURL.ValueString(), reason),
+			)
+			return
+		}
+	}
+
+	if r.providerData != nil {
+		resp.Diagnostics.Append(r.checkAPIVersion(ctx, req)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Only updates of existing endpoints can rotate the secret.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var preventSecretRotation types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("prevent_secret_rotation"), &preventSecretRotation)...)
+	if resp.Diagnostics.HasError() || !preventSecretRotation.ValueBool() {
+		return
+	}
+
+	// The RequiresReplace plan modifiers of the attributes only run after
+	// ModifyPlan, so the replacing changes are found by comparing plan and
+	// state.
+	for _, p := range webhookEndpointReplaceAttributes {
+		var planValue, stateValue attr.Value
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &stateValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planValue.Equal(stateValue) {
+			resp.Diagnostics.AddAttributeError(
+				p,
+				"Webhook Endpoint Replacement Prevented",
+				fmt.Sprintf("Changing %s requires replacing the webhook endpoint, which rotates its secret. "+
+					"Set prevent_secret_rotation to false to allow the replacement.", p),
+			)
+		}
+	}
+}
+
+// checkAPIVersion returns a warning when a new or changed api_version of the
+// endpoint differs from the API version of the provider. Events are then
+// rendered in a different shape than the objects the provider works with,
+// which code handling the events may not expect.
+func (r *WebhookEndpointResource) checkAPIVersion(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planVersion, stateVersion types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("api_version"), &planVersion)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("api_version"), &stateVersion)...)
+	}
+	if diags.HasError() || planVersion.IsNull() || planVersion.IsUnknown() || planVersion.Equal(stateVersion) {
+		return diags
+	}
+
+	if planVersion.ValueString() != r.providerData.APIVersion {
+		diags.AddAttributeWarning(
+			path.Root("api_version"),
+			"Webhook API Version Mismatch",
+			fmt.Sprintf("Events sent to this endpoint are rendered as API version %s, while the provider uses API version %s. "+
+				"Make sure the code handling the events expects API version %s.", planVersion.ValueString(), r.providerData.APIVersion, planVersion.ValueString()),
+		)
+	}
+	return diags
+}
+
+// developmentWebhookURLSuffixes are the host suffixes of tunnelling services
+// commonly used to receive webhooks on a development machine.
+var developmentWebhookURLSuffixes = []string{
+	".loca.lt",
+	".ngrok-free.app",
+	".ngrok-free.dev",
+	".ngrok.app",
+	".ngrok.dev",
+	".ngrok.io",
+	".trycloudflare.com",
+}
+
+// developmentWebhookURL describes why rawURL is obviously not a production
+// URL, or returns an empty string when it may be one. Unknown and unparsable
+// URLs are left to the API.
+func developmentWebhookURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "a localhost URL"
+	}
+	if ip := net.ParseIP(host); ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast()) {
+		return "a loopback or private network address"
+	}
+	if strings.HasSuffix(host, ".local") {
+		return "a local network host"
+	}
+	for _, suffix := range developmentWebhookURLSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return "a development tunnel"
+		}
+	}
+	return ""
 }
 
 func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -170,17 +331,32 @@ func (r *WebhookEndpointResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	resp.Diagnostics.Append(r.providerData.CheckRequireLivemode(plan.RequireLivemode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := r.buildCreateParams(plan)
 
 	webhookEndpoint, err = r.sc.WebhookEndpoints.New(params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", formatStripeError(err)))
 		return
 	}
 
 	plan.Id = types.StringValue(webhookEndpoint.ID)
 	plan.Secret = types.StringValue(webhookEndpoint.Secret)
-	r.populateModel(ctx, &plan, webhookEndpoint, resp.Diagnostics)
+	var populateDiags diag.Diagnostics
+	r.populateModel(ctx, &plan, webhookEndpoint, &populateDiags)
+	resp.Diagnostics.Append(populateDiags...)
+	if populateDiags.HasError() {
+		// Keep track of the created webhook endpoint so that it is not leaked. The
+		// error taints it, so it is replaced on the next apply. The secret is
+		// only returned on creation, so it is kept too.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret"), plan.Secret)...)
+		return
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -204,11 +380,16 @@ func (r *WebhookEndpointResource) Read(ctx context.Context, req resource.ReadReq
 
 	webhookEndpoint, err = r.sc.WebhookEndpoints.Get(state.Id.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook endpoint, got error: %s", formatStripeError(err)))
 		return
 	}
 
-	r.populateModel(ctx, &state, webhookEndpoint, resp.Diagnostics)
+	resp.Diagnostics.Append(checkStripeObject(webhookEndpoint.Object, "webhook_endpoint", webhookEndpoint.ID, "we_")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.populateModel(ctx, &state, webhookEndpoint, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -235,10 +416,10 @@ func (r *WebhookEndpointResource) Update(ctx context.Context, req resource.Updat
 
 	webhookEndpoint, err = r.sc.WebhookEndpoints.Update(plan.Id.ValueString(), params)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook endpoint, got error: %s", formatStripeError(err)))
 		return
 	}
-	r.populateModel(ctx, &plan, webhookEndpoint, resp.Diagnostics)
+	r.populateModel(ctx, &plan, webhookEndpoint, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -257,7 +438,7 @@ func (r *WebhookEndpointResource) Delete(ctx context.Context, req resource.Delet
 
 	_, err = r.sc.WebhookEndpoints.Del(state.Id.ValueString(), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook endpoint, got error: %s", formatStripeError(err)))
 		return
 	}
 }
@@ -267,22 +448,33 @@ func (r *WebhookEndpointResource) ImportState(ctx context.Context, req resource.
 	var webhookEndpoint *stripe.WebhookEndpoint
 	var err error
 
+	resp.Diagnostics.Append(checkImportID(req.ID, "webhook endpoint", "we_", false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	webhookEndpoint, err = r.sc.WebhookEndpoints.Get(req.ID, nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import webhook endpoint, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import webhook endpoint, got error: %s", formatStripeError(err)))
+		return
+	}
+
+	resp.Diagnostics.Append(checkStripeObject(webhookEndpoint.Object, "webhook_endpoint", webhookEndpoint.ID, "we_")...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	state.Id = types.StringValue(req.ID)
-	r.populateModel(ctx, &state, webhookEndpoint, resp.Diagnostics)
+	r.populateModel(ctx, &state, webhookEndpoint, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *WebhookEndpointResource) populateModel(ctx context.Context, model *WebhookEndpointResourceModel, webhookEndpoint *stripe.WebhookEndpoint, respDiag diag.Diagnostics) {
+func (r *WebhookEndpointResource) populateModel(ctx context.Context, model *WebhookEndpointResourceModel, webhookEndpoint *stripe.WebhookEndpoint, respDiag *diag.Diagnostics) {
 	model.APIVersion = StringNullIfEmpty(webhookEndpoint.APIVersion)
 	model.Application = StringNullIfEmpty(webhookEndpoint.Application)
+	model.Created = types.Int64Value(webhookEndpoint.Created)
 	model.Description = StringNullIfEmpty(webhookEndpoint.Description)
 	enabledEvents, diags := types.SetValueFrom(ctx, types.StringType, webhookEndpoint.EnabledEvents)
 	if diags.HasError() {
@@ -302,12 +494,22 @@ func (r *WebhookEndpointResource) populateModel(ctx context.Context, model *Webh
 		return
 	}
 	model.Metadata = MapValueNullIfEmpty(metadata, types.StringType)
+	model.MetadataKeys = metadataKeys(model.Metadata)
 	if webhookEndpoint.Status == "disabled" {
 		model.Disabled = types.BoolValue(true)
 	} else {
 		model.Disabled = types.BoolValue(false)
 	}
 	model.URL = types.StringValue(webhookEndpoint.URL)
+	model.ConfigHash = configHash(map[string]attr.Value{
+		"api_version":    model.APIVersion,
+		"connect":        model.Connect,
+		"description":    model.Description,
+		"disabled":       model.Disabled,
+		"enabled_events": model.EnabledEvents,
+		"metadata":       model.Metadata,
+		"url":            model.URL,
+	})
 }
 
 func (r *WebhookEndpointResource) buildCreateParams(plan WebhookEndpointResourceModel) *stripe.WebhookEndpointParams {
@@ -315,6 +517,9 @@ func (r *WebhookEndpointResource) buildCreateParams(plan WebhookEndpointResource
 	if !plan.APIVersion.IsNull() {
 		params.APIVersion = plan.APIVersion.ValueStringPointer()
 	}
+	if !plan.Connect.IsNull() {
+		params.Connect = plan.Connect.ValueBoolPointer()
+	}
 	if !plan.Description.IsNull() {
 		params.Description = plan.Description.ValueStringPointer()
 	}
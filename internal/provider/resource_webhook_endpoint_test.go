@@ -2,15 +2,20 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v81"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 const (
@@ -67,6 +72,7 @@ func TestAccWebhookEndpointResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "api_version", "2024-09-30.acacia"),
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "description", "test_create"),
+					resource.TestCheckResourceAttrSet("stripe_webhook_endpoint.test", "created"),
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "url", "https://example.com/test"),
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "enabled_events.#", "1"),
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "metadata.%", "1"),
@@ -89,9 +95,19 @@ func TestAccWebhookEndpointResource(t *testing.T) {
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "metadata.%", "2"),
 				),
 			},
-			// Replace and Read testing
+			// Replace and Read testing. Stripe reports a null api_version for
+			// endpoints on the account default, so the replacement must not
+			// plan another replace.
 			{
 				Config: testAccWebhookEndpointResourceConfigReplace,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("stripe_webhook_endpoint.test", plancheck.ResourceActionReplace),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckNoResourceAttr("stripe_webhook_endpoint.test", "api_version"),
 					resource.TestCheckResourceAttr("stripe_webhook_endpoint.test", "description", "test_replace"),
@@ -142,6 +158,18 @@ func TestBuildCreateParamsWebhookEndpointResource(t *testing.T) {
 				URL: stripe.String("https://example.com"),
 			},
 		},
+		{
+			name: "connect webhook",
+			plan: WebhookEndpointResourceModel{
+				Connect: types.BoolValue(true),
+				URL:     types.StringValue("https://example.com"),
+			},
+			expectErr: false,
+			expected: stripe.WebhookEndpointParams{
+				Connect: stripe.Bool(true),
+				URL:     stripe.String("https://example.com"),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -153,10 +181,36 @@ func TestBuildCreateParamsWebhookEndpointResource(t *testing.T) {
 			require.Equal(t, tt.expected.Description, params.Description, "Description should match")
 			require.Equal(t, tt.expected.Metadata, params.Metadata, "Metadata should match")
 			require.Equal(t, tt.expected.APIVersion, params.APIVersion, "APIVersion should match")
+			require.Equal(t, tt.expected.Connect, params.Connect, "Connect should match")
 		})
 	}
 }
 
+func TestCreateWebhookEndpointResourceConnect(t *testing.T) {
+	var form url.Values
+	r := &WebhookEndpointResource{
+		sc: testStripeClient(t, func(w http.ResponseWriter, req *http.Request) {
+			require.NoError(t, req.ParseForm())
+			form = req.PostForm
+			_, _ = w.Write([]byte(`{"id":"we_123","object":"webhook_endpoint","enabled_events":["account.updated"],"secret":"whsec_123","status":"enabled","url":"https://example.com"}`))
+		}),
+	}
+	plan := testPlan(t, r, map[string]interface{}{
+		"connect":        types.BoolValue(true),
+		"disabled":       types.BoolValue(false),
+		"enabled_events": testSetValue(t, types.StringType, []string{"account.updated"}),
+		"url":            types.StringValue("https://example.com"),
+	})
+	resp := &fwresource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), fwresource.CreateRequest{Plan: plan}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	require.Equal(t, "true", form.Get("connect"))
+	var connect types.Bool
+	resp.State.GetAttribute(context.Background(), path.Root("connect"), &connect)
+	require.Equal(t, types.BoolValue(true), connect)
+}
+
 func TestBuildUpdateParamsWebhookEndpointResource(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -281,6 +335,7 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			input: stripe.WebhookEndpoint{
 				APIVersion:    "2024-09-30",
 				Application:   "app_id",
+				Created:       1700000000,
 				Description:   "Test description",
 				EnabledEvents: []string{"event1", "event2"},
 				Metadata:      map[string]string{"key": "value"},
@@ -290,6 +345,7 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			expect: WebhookEndpointResourceModel{
 				APIVersion:    types.StringValue("2024-09-30"),
 				Application:   types.StringValue("app_id"),
+				Created:       types.Int64Value(1700000000),
 				Description:   types.StringValue("Test description"),
 				Disabled:      types.BoolValue(false),
 				EnabledEvents: testSetValue(t, types.StringType, []attr.Value{types.StringValue("event1"), types.StringValue("event2")}),
@@ -312,6 +368,7 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			expect: WebhookEndpointResourceModel{
 				APIVersion:    types.StringValue("2024-09-30"),
 				Application:   types.StringValue("app_id"),
+				Created:       types.Int64Value(0),
 				Description:   types.StringValue("Test description"),
 				Disabled:      types.BoolValue(false),
 				EnabledEvents: testSetValue(t, types.StringType, []attr.Value{types.StringValue("event1"), types.StringValue("event2")}),
@@ -334,6 +391,7 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			expect: WebhookEndpointResourceModel{
 				APIVersion:    types.StringValue("2024-09-30"),
 				Application:   types.StringValue("app_id"),
+				Created:       types.Int64Value(0),
 				Description:   types.StringValue("Test description"),
 				Disabled:      types.BoolValue(false),
 				EnabledEvents: testSetValue(t, types.StringType, []attr.Value{}),
@@ -356,6 +414,7 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			expect: WebhookEndpointResourceModel{
 				APIVersion:    types.StringNull(),
 				Application:   types.StringNull(),
+				Created:       types.Int64Value(0),
 				Description:   types.StringNull(),
 				Disabled:      types.BoolValue(false),
 				EnabledEvents: testSetValue(t, types.StringType, []attr.Value{}),
@@ -370,10 +429,11 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 			r := &WebhookEndpointResource{}
 			respDiag := diag.Diagnostics{}
 			ctx := context.Background()
-			r.populateModel(ctx, &tt.model, &tt.input, respDiag)
+			r.populateModel(ctx, &tt.model, &tt.input, &respDiag)
 
 			require.Equal(t, tt.expect.APIVersion, tt.model.APIVersion, "APIVersion should match")
 			require.Equal(t, tt.expect.Application, tt.model.Application, "Application should match")
+			require.Equal(t, tt.expect.Created, tt.model.Created, "Created should match")
 			require.Equal(t, tt.expect.Description, tt.model.Description, "Description should match")
 			require.Equal(t, tt.expect.Disabled, tt.model.Disabled, "Status should match")
 			require.Equal(t, tt.expect.EnabledEvents, tt.model.EnabledEvents, "EnabledEvents should match")
@@ -382,3 +442,172 @@ func TestPopulateModelWebhookEndpointResource(t *testing.T) {
 		})
 	}
 }
+
+func TestModifyPlanWebhookEndpointResourcePreventSecretRotation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		preventSecretRotation types.Bool
+		stateAPIVersion       types.String
+		planAPIVersion        types.String
+		stateConnect          types.Bool
+		planConnect           types.Bool
+		expectErr             bool
+	}{
+		{
+			name:                  "api_version replace blocked",
+			preventSecretRotation: types.BoolValue(true),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringValue("2024-10-28.acacia"),
+			stateConnect:          types.BoolNull(),
+			planConnect:           types.BoolNull(),
+			expectErr:             true,
+		},
+		{
+			name:                  "api_version removal blocked",
+			preventSecretRotation: types.BoolValue(true),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringNull(),
+			stateConnect:          types.BoolNull(),
+			planConnect:           types.BoolNull(),
+			expectErr:             true,
+		},
+		{
+			name:                  "connect replace blocked",
+			preventSecretRotation: types.BoolValue(true),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringValue("2024-09-30.acacia"),
+			stateConnect:          types.BoolValue(false),
+			planConnect:           types.BoolValue(true),
+			expectErr:             true,
+		},
+		{
+			name:                  "replace allowed",
+			preventSecretRotation: types.BoolValue(false),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringNull(),
+			stateConnect:          types.BoolValue(false),
+			planConnect:           types.BoolValue(true),
+			expectErr:             false,
+		},
+		{
+			name:                  "replace allowed when unset",
+			preventSecretRotation: types.BoolNull(),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringValue("2024-10-28.acacia"),
+			stateConnect:          types.BoolNull(),
+			planConnect:           types.BoolNull(),
+			expectErr:             false,
+		},
+		{
+			name:                  "in-place update",
+			preventSecretRotation: types.BoolValue(true),
+			stateAPIVersion:       types.StringValue("2024-09-30.acacia"),
+			planAPIVersion:        types.StringValue("2024-09-30.acacia"),
+			stateConnect:          types.BoolValue(true),
+			planConnect:           types.BoolValue(true),
+			expectErr:             false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &WebhookEndpointResource{}
+			req := fwresource.ModifyPlanRequest{
+				State: testState(t, r, map[string]interface{}{
+					"id":                      types.StringValue("we_123"),
+					"api_version":             tt.stateAPIVersion,
+					"connect":                 tt.stateConnect,
+					"prevent_secret_rotation": tt.preventSecretRotation,
+					"url":                     types.StringValue("https://example.com"),
+				}),
+				Plan: testPlan(t, r, map[string]interface{}{
+					"id":                      types.StringValue("we_123"),
+					"api_version":             tt.planAPIVersion,
+					"connect":                 tt.planConnect,
+					"prevent_secret_rotation": tt.preventSecretRotation,
+					"url":                     types.StringValue("https://example.com"),
+				}),
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestModifyPlanWebhookEndpointResourceLiveModeURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		liveAPIKey bool
+		url        string
+		expectErr  bool
+	}{
+		{"production URL", true, "https://example.com/webhooks", false},
+		{"localhost", true, "https://localhost:8080/webhooks", true},
+		{"loopback address", true, "https://127.0.0.1/webhooks", true},
+		{"private address", true, "https://192.168.1.10/webhooks", true},
+		{"mDNS host", true, "https://devbox.local/webhooks", true},
+		{"ngrok tunnel", true, "https://abc123.ngrok-free.app/webhooks", true},
+		{"cloudflare tunnel", true, "https://words-here.trycloudflare.com/webhooks", true},
+		{"test mode tunnel", false, "https://abc123.ngrok-free.app/webhooks", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &WebhookEndpointResource{providerData: &StripeProviderData{LiveAPIKey: tt.liveAPIKey}}
+			req := fwresource.ModifyPlanRequest{
+				State: testState(t, r, nil),
+				Plan: testPlan(t, r, map[string]interface{}{
+					"url": types.StringValue(tt.url),
+				}),
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.expectErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestModifyPlanWebhookEndpointResourceAPIVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		stateVersion types.String
+		planVersion  types.String
+		expectWarn   bool
+	}{
+		{"unset", types.StringNull(), types.StringNull(), false},
+		{"matching", types.StringNull(), types.StringValue("2024-12-18.acacia"), false},
+		{"mismatch", types.StringNull(), types.StringValue("2023-10-16"), true},
+		{"unchanged mismatch", types.StringValue("2023-10-16"), types.StringValue("2023-10-16"), false},
+		{"changed to mismatch", types.StringValue("2024-12-18.acacia"), types.StringValue("2023-10-16"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &WebhookEndpointResource{providerData: &StripeProviderData{APIVersion: "2024-12-18.acacia"}}
+			state := testState(t, r, nil)
+			if !tt.stateVersion.IsNull() {
+				state = testState(t, r, map[string]interface{}{
+					"id":          types.StringValue("we_123"),
+					"api_version": tt.stateVersion,
+					"url":         types.StringValue("https://example.com/webhooks"),
+				})
+			}
+			req := fwresource.ModifyPlanRequest{
+				State: state,
+				Plan: testPlan(t, r, map[string]interface{}{
+					"id":          types.StringValue("we_123"),
+					"api_version": tt.planVersion,
+					"url":         types.StringValue("https://example.com/webhooks"),
+				}),
+			}
+			resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, tt.expectWarn, resp.Diagnostics.WarningsCount() == 1, resp.Diagnostics)
+		})
+	}
+}
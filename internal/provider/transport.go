@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// unavailableMaxRetries is how many times a request answered with 503
+	// Service Unavailable is retried before the response is handed back to
+	// the Stripe library.
+	unavailableMaxRetries = 3
+
+	// unavailableBackoff is the delay before the first retry of a 503, doubled
+	// on every further retry. It is longer than the Stripe library's backoff
+	// since maintenance and incidents typically last longer than a lock
+	// timeout.
+	unavailableBackoff = 2 * time.Second
+
+	// stripeShouldRetryHeader is the response header with which Stripe tells
+	// clients whether retrying a request may succeed.
+	stripeShouldRetryHeader = "Stripe-Should-Retry"
+)
+
+// unavailableRetryTransport retries requests that Stripe answers with 503
+// Service Unavailable using a longer backoff than the Stripe library applies.
+// Other retryable responses are left to the Stripe library. Responses that
+// Stripe marks as not retryable are returned as is, and requests that change
+// data are only retried when they carry an idempotency key. Retried requests
+// keep their headers, including the idempotency key.
+//
+// Once its retries are exhausted, the transport marks the response as not
+// retryable, so that the Stripe library does not retry it again on top.
+type unavailableRetryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *unavailableRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.backoff
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || !t.shouldRetry(req, resp) {
+			return resp, err
+		}
+		if attempt >= t.maxRetries {
+			resp.Header.Set(stripeShouldRetryHeader, "false")
+			return resp, err
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// shouldRetry reports whether the transport retries req after resp.
+func (t *unavailableRetryTransport) shouldRetry(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get(stripeShouldRetryHeader) == "false" {
+		return false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// userAgentTransport appends a suffix to the User-Agent header set by the
+// Stripe library, so that Stripe can attribute the traffic of a provider
+// instance. It is applied per HTTP client, unlike stripe.SetAppInfo, which is
+// global to the process.
+type userAgentTransport struct {
+	base   http.RoundTripper
+	suffix string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", strings.TrimSpace(req.Header.Get("User-Agent")+" "+t.suffix))
+	return t.base.RoundTrip(req)
+}
+
+// requestLogTransport logs the method, path and response status of requests
+// to the Stripe API. Bodies and headers are left out, as they carry the API
+// key and customer data. The Stripe library sends requests without the
+// context of the Terraform operation, so the transport logs to the logger of
+// the context the provider was configured with.
+type requestLogTransport struct {
+	base http.RoundTripper
+	ctx  context.Context
+}
+
+func (t *requestLogTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(t.ctx, "Stripe API request failed", fields)
+		return resp, err
+	}
+	fields["status"] = resp.StatusCode
+	if requestID := resp.Header.Get("Request-Id"); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.Debug(t.ctx, "Stripe API request", fields)
+	return resp, err
+}
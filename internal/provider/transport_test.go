@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnavailableRetryTransport(t *testing.T) {
+	tests := []struct {
+		name            string
+		status          int
+		failures        int
+		shouldRetry     string
+		idempotencyKey  string
+		wantStatus      int
+		wantRequests    int
+		wantShouldRetry string
+	}{
+		{"available", http.StatusServiceUnavailable, 0, "", "key_123", http.StatusOK, 1, ""},
+		{"recovers", http.StatusServiceUnavailable, 2, "", "key_123", http.StatusOK, 3, ""},
+		{"retries exhausted", http.StatusServiceUnavailable, 5, "", "key_123", http.StatusServiceUnavailable, 4, "false"},
+		{"retry advised", http.StatusServiceUnavailable, 1, "true", "key_123", http.StatusOK, 2, ""},
+		{"retry advised against", http.StatusServiceUnavailable, 1, "false", "key_123", http.StatusServiceUnavailable, 1, "false"},
+		{"post without idempotency key", http.StatusServiceUnavailable, 1, "", "", http.StatusServiceUnavailable, 1, ""},
+		{"conflict left to the library", http.StatusConflict, 1, "", "key_123", http.StatusConflict, 1, ""},
+		{"server error left to the library", http.StatusInternalServerError, 1, "", "key_123", http.StatusInternalServerError, 1, ""},
+		{"rate limited not retried", http.StatusTooManyRequests, 1, "", "key_123", http.StatusTooManyRequests, 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				body, _ := io.ReadAll(req.Body)
+				assert.Equal(t, "name=test", string(body))
+				assert.Equal(t, tt.idempotencyKey, req.Header.Get("Idempotency-Key"))
+				if requests <= tt.failures {
+					if tt.shouldRetry != "" {
+						w.Header().Set("Stripe-Should-Retry", tt.shouldRetry)
+					}
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			t.Cleanup(server.Close)
+
+			httpClient := &http.Client{
+				Transport: &unavailableRetryTransport{
+					base:       http.DefaultTransport,
+					maxRetries: 3,
+					backoff:    time.Millisecond,
+				},
+			}
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("name=test"))
+			require.NoError(t, err)
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+
+			resp, err := httpClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.Equal(t, tt.wantShouldRetry, resp.Header.Get("Stripe-Should-Retry"))
+		})
+	}
+}
+
+func TestUnavailableRetryTransportGet(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := &http.Client{
+		Transport: &unavailableRetryTransport{
+			base:       http.DefaultTransport,
+			maxRetries: 3,
+			backoff:    time.Millisecond,
+		},
+	}
+	resp, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+}
+
+func TestRequestLogTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Request-Id", "req_123")
+		w.WriteHeader(http.StatusPaymentRequired)
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	httpClient := &http.Client{
+		Transport: &requestLogTransport{
+			base: http.DefaultTransport,
+			ctx:  tflogtest.RootLogger(context.Background(), &output),
+		},
+	}
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v1/products?expand[]=default_price", strings.NewReader("name=secret"))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer sk_test_123")
+
+	resp, err := httpClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	logged := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "debug", entries[0]["@level"])
+	assert.Equal(t, "Stripe API request", entries[0]["@message"])
+	assert.Equal(t, "POST", entries[0]["method"])
+	assert.Equal(t, "/v1/products", entries[0]["path"])
+	assert.Equal(t, float64(http.StatusPaymentRequired), entries[0]["status"])
+	assert.Equal(t, "req_123", entries[0]["request_id"])
+	assert.NotContains(t, logged, "secret")
+	assert.NotContains(t, logged, "sk_test_123")
+}
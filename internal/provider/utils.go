@@ -1,10 +1,26 @@
 package provider
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stripe/stripe-go/v81"
+
+	"github.com/zkoesters/terraform-provider-stripe/internal/provider/validator/custommapvalidator"
 )
 
 func convertListToStringPtrs(tflist types.List) []*string {
@@ -86,3 +102,247 @@ func EmptyStringIfNull(s basetypes.StringValue) *string {
 	}
 	return s.ValueStringPointer()
 }
+
+// isNotFound reports whether err is a Stripe API error for an object that no
+// longer exists.
+func isNotFound(err error) bool {
+	var stripeErr *stripe.Error
+	if errors.As(err, &stripeErr) {
+		return stripeErr.HTTPStatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a Stripe API response with the given
+// status may succeed when retried: conflicts and server errors, including 503
+// Service Unavailable during maintenance or incidents. Rate limited 429
+// responses are not, since retrying them adds to the contention; lock
+// timeouts share that status and are told apart by isRetryable.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusConflict || statusCode >= http.StatusInternalServerError
+}
+
+// isRetryable reports whether err is a Stripe API error that may succeed when
+// retried: an error with a retryable status, or a lock timeout. The Stripe
+// library retries these, and unavailableRetryTransport retries 503 Service
+// Unavailable with a longer backoff first.
+func isRetryable(err error) bool {
+	var stripeErr *stripe.Error
+	if !errors.As(err, &stripeErr) {
+		return false
+	}
+	if stripeErr.HTTPStatusCode == http.StatusTooManyRequests {
+		return stripeErr.Code == stripe.ErrorCodeLockTimeout
+	}
+	return isRetryableStatus(stripeErr.HTTPStatusCode)
+}
+
+// formatStripeError formats err for a diagnostic. Retryable errors that are
+// still returned have exhausted their retries, so the message says so, and
+// points at the Stripe status page when Stripe is unavailable. Errors for a
+// field that was cleared, which Stripe does not allow for some fields, explain
+// how to remove the value instead.
+func formatStripeError(err error) string {
+	var stripeErr *stripe.Error
+	if errors.As(err, &stripeErr) && stripeErr.Code == stripe.ErrorCodeParameterInvalidEmpty {
+		field := "this field"
+		if stripeErr.Param != "" {
+			field = fmt.Sprintf("%q", stripeErr.Param)
+		}
+		return fmt.Sprintf("%s (Stripe does not allow clearing %s once it is set; set it to another value, or replace the resource to remove it)", err, field)
+	}
+	if !isRetryable(err) {
+		return err.Error()
+	}
+	if stripeErr.HTTPStatusCode == http.StatusServiceUnavailable {
+		return fmt.Sprintf("%s (Stripe is unavailable and retries were exhausted; check https://status.stripe.com for ongoing incidents or maintenance and try again later)", err)
+	}
+	return fmt.Sprintf("%s (retries were exhausted)", err)
+}
+
+// requireLivemodeAttribute returns the schema of the require_livemode
+// attribute shared by all resources, checked with
+// StripeProviderData.CheckRequireLivemode on create.
+func requireLivemodeAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: "When set, creation fails unless the provider's API key is a live mode (`true`) or test mode (`false`) key. Guards against creating test objects in live mode and vice versa.",
+		Optional:            true,
+	}
+}
+
+// configHashAttribute returns the schema of the config_hash attribute shared
+// by all resources, set with configHash when the model is populated.
+func configHashAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "A stable SHA-256 hash of the managed attributes of the object as read from Stripe. It only changes when one of those attributes changes, which makes it suitable for change detection in outputs.",
+		Computed:            true,
+	}
+}
+
+// configHash returns a stable hash of the given attribute values, keyed by
+// attribute name. Set elements are sorted, so their order does not matter.
+func configHash(values map[string]attr.Value) types.String {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		value := values[k].String()
+		if set, ok := values[k].(types.Set); ok && !set.IsNull() && !set.IsUnknown() {
+			elements := make([]string, 0, len(set.Elements()))
+			for _, element := range set.Elements() {
+				elements = append(elements, element.String())
+			}
+			sort.Strings(elements)
+			value = "[" + strings.Join(elements, ",") + "]"
+		}
+		fmt.Fprintf(h, "%q=%s\n", k, value)
+	}
+	return types.StringValue(hex.EncodeToString(h.Sum(nil)))
+}
+
+// stripeIDPrefixes maps the ID prefixes of common Stripe objects to the name
+// of the object, used to explain import IDs of the wrong object type.
+var stripeIDPrefixes = map[string]string{
+	"cus_":   "customer",
+	"di_":    "discount",
+	"in_":    "invoice",
+	"price_": "price",
+	"prod_":  "product",
+	"promo_": "promotion code",
+	"sub_":   "subscription",
+	"txr_":   "tax rate",
+	"we_":    "webhook endpoint",
+}
+
+// checkImportID validates the ID passed to ImportState before it is looked up,
+// catching IDs copied from the wrong object type. IDs must start with prefix,
+// unless the object type supports custom IDs, in which case only IDs that
+// carry the prefix of another object type are rejected.
+func checkImportID(id, objectName, prefix string, customIDs bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if prefix != "" && strings.HasPrefix(id, prefix) {
+		return diags
+	}
+
+	for otherPrefix, otherName := range stripeIDPrefixes {
+		if otherPrefix != prefix && strings.HasPrefix(id, otherPrefix) {
+			diags.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("The import ID %q looks like a %s ID, but a %s ID was expected. Check that the ID was copied from the right object.", id, otherName, objectName),
+			)
+			return diags
+		}
+	}
+
+	if !customIDs {
+		diags.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID %q is not a %s ID, which starts with %q.", id, objectName, prefix),
+		)
+	}
+	return diags
+}
+
+// checkStripeObject validates the object returned by Stripe before it is
+// populated into the model, guarding against the API returning another type
+// of object than was asked for. The ID must start with prefix, unless prefix
+// is empty because the object type supports custom IDs.
+func checkStripeObject(object, expectedObject, id, prefix string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if object != expectedObject {
+		diags.AddError(
+			"Unexpected Stripe Object",
+			fmt.Sprintf("Expected a %s object for %q, but Stripe returned a %s object.", expectedObject, id, object),
+		)
+		return diags
+	}
+	if prefix != "" && !strings.HasPrefix(id, prefix) {
+		diags.AddError(
+			"Unexpected Stripe Object",
+			fmt.Sprintf("Expected the ID of the %s object to start with %q, got %q.", expectedObject, prefix, id),
+		)
+	}
+	return diags
+}
+
+// metadataAttribute returns the schema of the metadata attribute shared by all
+// resources. Keys and values that are too long are reported by key.
+func metadataAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "Set of key-value pairs that you can attach to an object. Keys can be up to 40 characters long and values up to 500 characters long. Keys must not be empty or contain square brackets.",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Validators: []validator.Map{
+			mapvalidator.SizeAtMost(50),
+			custommapvalidator.Metadata(),
+		},
+	}
+}
+
+// metadataKeysAttribute returns the schema of the metadata_keys attribute
+// shared by all resources, set with metadataKeys when the model is populated.
+func metadataKeysAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "The keys of `metadata` in ascending order, or an empty list without metadata. Useful for tooling that iterates over the tags of an object.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+// metadataKeys returns the keys of metadata in ascending order. Null metadata
+// has no keys, so tooling can iterate over the list without checking for null.
+func metadataKeys(metadata types.Map) types.List {
+	keys := make([]string, 0, len(metadata.Elements()))
+	for k := range metadata.Elements() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	elements := make([]attr.Value, 0, len(keys))
+	for _, k := range keys {
+		elements = append(elements, types.StringValue(k))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// RangeQueryModel describes a timestamp range filter of a list data source.
+type RangeQueryModel struct {
+	Gt  types.Int64 `tfsdk:"gt"`
+	Gte types.Int64 `tfsdk:"gte"`
+	Lt  types.Int64 `tfsdk:"lt"`
+	Lte types.Int64 `tfsdk:"lte"`
+}
+
+func (m RangeQueryModel) Types() map[string]attr.Type {
+	return map[string]attr.Type{
+		"gt":  types.Int64Type,
+		"gte": types.Int64Type,
+		"lt":  types.Int64Type,
+		"lte": types.Int64Type,
+	}
+}
+
+// rangeQueryParams returns the params of a configured timestamp range, or nil
+// if the range is not set. Stripe ignores bounds that are zero.
+func rangeQueryParams(ctx context.Context, rangeQuery types.Object, respDiag *diag.Diagnostics) *stripe.RangeQueryParams {
+	if rangeQuery.IsNull() || rangeQuery.IsUnknown() {
+		return nil
+	}
+
+	var model RangeQueryModel
+	respDiag.Append(rangeQuery.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	return &stripe.RangeQueryParams{
+		GreaterThan:        model.Gt.ValueInt64(),
+		GreaterThanOrEqual: model.Gte.ValueInt64(),
+		LesserThan:         model.Lt.ValueInt64(),
+		LesserThanOrEqual:  model.Lte.ValueInt64(),
+	}
+}
+
+// httpsURLRegexp matches an HTTPS URL, as required by Stripe for webhook
+// endpoints and product images.
+var httpsURLRegexp = regexp.MustCompile(`^https://\S+$`)
@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"errors"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -167,3 +170,176 @@ func TestEmptyStringIfNull(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"not a stripe error", errors.New("boom"), false},
+		{"bad request", &stripe.Error{HTTPStatusCode: http.StatusBadRequest}, false},
+		{"not found", &stripe.Error{HTTPStatusCode: http.StatusNotFound}, false},
+		{"conflict", &stripe.Error{HTTPStatusCode: http.StatusConflict}, true},
+		{"rate limited", &stripe.Error{HTTPStatusCode: http.StatusTooManyRequests, Code: stripe.ErrorCodeRateLimit}, false},
+		{"lock timeout", &stripe.Error{HTTPStatusCode: http.StatusTooManyRequests, Code: stripe.ErrorCodeLockTimeout}, true},
+		{"internal server error", &stripe.Error{HTTPStatusCode: http.StatusInternalServerError}, true},
+		{"service unavailable", &stripe.Error{HTTPStatusCode: http.StatusServiceUnavailable}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStripeError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus bool
+		wantClear  bool
+	}{
+		{"not retryable", &stripe.Error{HTTPStatusCode: http.StatusBadRequest, Msg: "Invalid request"}, false, false},
+		{"server error", &stripe.Error{HTTPStatusCode: http.StatusInternalServerError, Msg: "Internal error"}, false, false},
+		{"service unavailable", &stripe.Error{HTTPStatusCode: http.StatusServiceUnavailable, Msg: "Service unavailable"}, true, false},
+		{"cleared field", &stripe.Error{HTTPStatusCode: http.StatusBadRequest, Code: stripe.ErrorCodeParameterInvalidEmpty, Param: "statement_descriptor", Msg: "You passed an empty string for 'statement_descriptor'."}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatStripeError(tt.err)
+			if !strings.HasPrefix(got, tt.err.Error()) {
+				t.Errorf("formatStripeError() = %q, want prefix %q", got, tt.err.Error())
+			}
+			if strings.Contains(got, "status.stripe.com") != tt.wantStatus {
+				t.Errorf("formatStripeError() = %q, status page hint expected: %v", got, tt.wantStatus)
+			}
+			if strings.Contains(got, `clearing "statement_descriptor"`) != tt.wantClear {
+				t.Errorf("formatStripeError() = %q, clearing hint expected: %v", got, tt.wantClear)
+			}
+		})
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	events := func(values ...string) types.Set {
+		return types.SetValueMust(types.StringType, func() []attr.Value {
+			var elements []attr.Value
+			for _, v := range values {
+				elements = append(elements, types.StringValue(v))
+			}
+			return elements
+		}())
+	}
+
+	base := configHash(map[string]attr.Value{
+		"enabled_events": events("a", "b"),
+		"url":            types.StringValue("https://example.com"),
+	})
+
+	if got := configHash(map[string]attr.Value{
+		"url":            types.StringValue("https://example.com"),
+		"enabled_events": events("b", "a"),
+	}); got != base {
+		t.Errorf("configHash() = %s, want %s regardless of set order", got, base)
+	}
+	if got := configHash(map[string]attr.Value{
+		"enabled_events": events("a", "b"),
+		"url":            types.StringValue("https://example.org"),
+	}); got == base {
+		t.Errorf("configHash() = %s, want a different hash for a changed value", got)
+	}
+	if got := configHash(map[string]attr.Value{
+		"enabled_events": events("a", "b"),
+		"url":            types.StringNull(),
+	}); got == base {
+		t.Errorf("configHash() = %s, want a different hash for a null value", got)
+	}
+}
+
+func TestMetadataKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata types.Map
+		want     types.List
+	}{
+		{"null", types.MapNull(types.StringType), types.ListValueMust(types.StringType, []attr.Value{})},
+		{"empty", types.MapValueMust(types.StringType, map[string]attr.Value{}), types.ListValueMust(types.StringType, []attr.Value{})},
+		{"sorted", types.MapValueMust(types.StringType, map[string]attr.Value{
+			"team":        types.StringValue("billing"),
+			"cost_center": types.StringValue("42"),
+			"plan":        types.StringValue("standard"),
+		}), types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("cost_center"),
+			types.StringValue("plan"),
+			types.StringValue("team"),
+		})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metadataKeys(tt.metadata); !got.Equal(tt.want) {
+				t.Errorf("metadataKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckImportID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		objectName string
+		prefix     string
+		customIDs  bool
+		wantErr    bool
+	}{
+		{"price", "price_123", "price", "price_", false, false},
+		{"product as price", "prod_123", "price", "price_", false, true},
+		{"unknown prefix", "abc_123", "webhook endpoint", "we_", false, true},
+		{"product", "prod_123", "product", "prod_", true, false},
+		{"custom product id", "standard-plan", "product", "prod_", true, false},
+		{"price as product", "price_123", "product", "prod_", true, true},
+		{"custom coupon id", "SUMMER", "coupon", "", true, false},
+		{"promotion code as coupon", "promo_123", "coupon", "", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkImportID(tt.id, tt.objectName, tt.prefix, tt.customIDs)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("checkImportID() = %v, want error %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckStripeObject(t *testing.T) {
+	tests := []struct {
+		name           string
+		object         string
+		expectedObject string
+		id             string
+		prefix         string
+		wantErr        bool
+	}{
+		{"price", "price", "price", "price_123", "price_", false},
+		{"product as price", "product", "price", "prod_123", "price_", true},
+		{"wrong prefix", "price", "price", "prod_123", "price_", true},
+		{"custom product id", "product", "product", "standard-plan", "", false},
+		{"coupon as product", "coupon", "product", "SUMMER", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := checkStripeObject(tt.object, tt.expectedObject, tt.id, tt.prefix)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("checkStripeObject() = %v, want error %v", diags, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package custommapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// metadataKeyMaxLength is the maximum length of a Stripe metadata key.
+	metadataKeyMaxLength = 40
+
+	// metadataValueMaxLength is the maximum length of a Stripe metadata value.
+	metadataValueMaxLength = 500
+)
+
+func Metadata() validator.Map {
+	return metadataValidator{}
+}
+
+// metadataValidator is a validator that checks the keys and value lengths of
+// a Stripe metadata map, reporting each offending key by name. Stripe rejects
+// empty keys and keys with square brackets, which it uses to encode nested
+// parameters.
+type metadataValidator struct{}
+
+// Description returns a human-readable description of the validator.
+func (v metadataValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("keys must be non-empty, without square brackets and at most %d characters, and values at most %d characters", metadataKeyMaxLength, metadataValueMaxLength)
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v metadataValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateMap implements the validation logic.
+func (v metadataValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := req.ConfigValue.Elements()
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if key == "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Metadata Key",
+				"Metadata keys must not be empty.",
+			)
+		}
+		if strings.ContainsAny(key, "[]") {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Metadata Key",
+				fmt.Sprintf("Metadata key %q must not contain square brackets.", key),
+			)
+		}
+		if n := utf8.RuneCountInString(key); n > metadataKeyMaxLength {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Metadata Key",
+				fmt.Sprintf("Metadata key %q must be at most %d characters, got: %d", key, metadataKeyMaxLength, n),
+			)
+		}
+
+		value, ok := elements[key].(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if n := utf8.RuneCountInString(value.ValueString()); n > metadataValueMaxLength {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(key),
+				"Invalid Metadata Value",
+				fmt.Sprintf("Metadata value of key %q must be at most %d characters, got: %d", key, metadataValueMaxLength, n),
+			)
+		}
+	}
+}
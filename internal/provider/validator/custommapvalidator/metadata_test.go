@@ -0,0 +1,103 @@
+package custommapvalidator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMetadataValidator(t *testing.T) {
+	longKey := strings.Repeat("k", 41)
+	longValue := strings.Repeat("v", 501)
+
+	tests := []struct {
+		name      string
+		value     types.Map
+		wantPaths []path.Path
+	}{
+		{
+			name:  "null",
+			value: types.MapNull(types.StringType),
+		},
+		{
+			name: "valid",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				strings.Repeat("k", 40): types.StringValue(strings.Repeat("v", 500)),
+			}),
+		},
+		{
+			name: "multibyte characters",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"plan": types.StringValue(strings.Repeat("é", 500)),
+			}),
+		},
+		{
+			name: "long key",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"plan":  types.StringValue("standard"),
+				longKey: types.StringValue("value"),
+			}),
+			wantPaths: []path.Path{path.Root("metadata").AtMapKey(longKey)},
+		},
+		{
+			name: "long values",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"b":    types.StringValue(longValue),
+				"a":    types.StringValue(longValue),
+				"plan": types.StringValue("standard"),
+			}),
+			wantPaths: []path.Path{
+				path.Root("metadata").AtMapKey("a"),
+				path.Root("metadata").AtMapKey("b"),
+			},
+		},
+		{
+			name: "empty key",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"":     types.StringValue("value"),
+				"plan": types.StringValue("standard"),
+			}),
+			wantPaths: []path.Path{path.Root("metadata").AtMapKey("")},
+		},
+		{
+			name: "square brackets",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"plan[tier]": types.StringValue("standard"),
+				"team":       types.StringValue("billing"),
+			}),
+			wantPaths: []path.Path{path.Root("metadata").AtMapKey("plan[tier]")},
+		},
+		{
+			name: "unknown value",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"plan": types.StringUnknown(),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.MapRequest{
+				Path:        path.Root("metadata"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.MapResponse{}
+			Metadata().ValidateMap(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != len(tt.wantPaths) {
+				t.Fatalf("got %d errors, want %d: %v", got, len(tt.wantPaths), resp.Diagnostics)
+			}
+			for i, d := range resp.Diagnostics.Errors() {
+				withPath, ok := d.(interface{ Path() path.Path })
+				if !ok || !withPath.Path().Equal(tt.wantPaths[i]) {
+					t.Errorf("error %d is not for %s: %v", i, tt.wantPaths[i], d)
+				}
+			}
+		})
+	}
+}
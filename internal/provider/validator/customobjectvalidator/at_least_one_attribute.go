@@ -0,0 +1,54 @@
+package customobjectvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func AtLeastOneAttribute(names ...string) validator.Object {
+	return atLeastOneAttributeValidator{
+		names: names,
+	}
+}
+
+// atLeastOneAttributeValidator is a validator that requires at least one of
+// the given attributes of the object itself to be set. Unlike
+// objectvalidator.AtLeastOneOf, which counts the object as set, it looks at
+// the attributes within the object.
+type atLeastOneAttributeValidator struct {
+	names []string
+}
+
+// Description returns a human-readable description of the validator.
+func (v atLeastOneAttributeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("at least one of the attributes %s must be set", strings.Join(v.names, ", "))
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v atLeastOneAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateObject implements the validation logic.
+func (v atLeastOneAttributeValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := req.ConfigValue.Attributes()
+	for _, name := range v.names {
+		// An unknown attribute may still be set once it is known.
+		if value, ok := attributes[name]; ok && !value.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Missing Attribute Configuration",
+		fmt.Sprintf("At least one of the attributes %s must be set.", strings.Join(v.names, ", ")),
+	)
+}
@@ -0,0 +1,78 @@
+package customobjectvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAtLeastOneAttributeValidator(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"flat_amount": types.Int64Type,
+		"unit_amount": types.Int64Type,
+		"up_to":       types.Int64Type,
+	}
+
+	tests := []struct {
+		name    string
+		value   types.Object
+		wantErr bool
+	}{
+		{
+			name:  "null",
+			value: types.ObjectNull(attrTypes),
+		},
+		{
+			name: "first set",
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"flat_amount": types.Int64Value(100),
+				"unit_amount": types.Int64Null(),
+				"up_to":       types.Int64Value(10),
+			}),
+		},
+		{
+			name: "second set",
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"flat_amount": types.Int64Null(),
+				"unit_amount": types.Int64Value(0),
+				"up_to":       types.Int64Value(10),
+			}),
+		},
+		{
+			name: "unknown",
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"flat_amount": types.Int64Unknown(),
+				"unit_amount": types.Int64Null(),
+				"up_to":       types.Int64Value(10),
+			}),
+		},
+		{
+			name: "none set",
+			value: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"flat_amount": types.Int64Null(),
+				"unit_amount": types.Int64Null(),
+				"up_to":       types.Int64Value(10),
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ObjectRequest{
+				Path:        path.Root("tiers").AtListIndex(0),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.ObjectResponse{}
+			AtLeastOneAttribute("flat_amount", "unit_amount").ValidateObject(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("got errors %v, want error %v", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}